@@ -10,8 +10,10 @@ import (
 	altsrc "github.com/urfave/cli-altsrc/v3"
 	"github.com/urfave/cli/v3"
 
+	"github.com/tzrikka/ovid/internal/inbound"
 	"github.com/tzrikka/ovid/internal/temporal"
 	"github.com/tzrikka/ovid/internal/thrippy"
+	"github.com/tzrikka/ovid/pkg/client"
 	"github.com/tzrikka/ovid/pkg/slack"
 	"github.com/tzrikka/xdg"
 )
@@ -50,9 +52,15 @@ func flags() []cli.Flag {
 	path := configFile()
 	fs = append(fs, temporal.Flags(path)...)
 	fs = append(fs, thrippy.Flags(path)...)
+	fs = append(fs, client.Flags(path)...)
 
 	// Supported Thrippy Links IDs.
-	fs = append(fs, slack.LinkIDFlag(path))
+	fs = append(fs, slack.LinkIDFlag(path), slack.LinksFlag(path))
+
+	// Link-specific settings.
+	fs = append(fs, slack.RateLimitFlags(path)...)
+	fs = append(fs, slack.UploadDirFlag(path))
+	fs = append(fs, inbound.Flags(path)...)
 
 	return fs
 }
@@ -0,0 +1,60 @@
+package inbound
+
+import (
+	"sync"
+	"time"
+)
+
+// Slack retries failed or slow event deliveries up to 3 times,
+// within about 5 minutes: https://docs.slack.dev/apis/events-api/#retries
+const eventTTL = 10 * time.Minute
+
+// seenEvents remembers the IDs of recently dispatched Slack events, so that
+// redelivered events (with an "X-Slack-Retry-Num" header in HTTP requests, or a
+// "retry_attempt" in Socket Mode) don't signal the same workflow more than once.
+// The zero value is ready to use.
+type seenEvents struct {
+	mu        sync.Mutex
+	expiry    map[string]time.Time
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// claim reports whether the event ID wasn't claimed recently, and if so
+// claims it. Event IDs which are being dispatched are also considered claimed,
+// so concurrent retries are acknowledged without dispatching them again.
+func (s *seenEvents) claim(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.now != nil {
+		now = s.now()
+	}
+
+	if s.expiry == nil {
+		s.expiry = map[string]time.Time{}
+	}
+	if now.Sub(s.lastSweep) > time.Minute {
+		for k, t := range s.expiry {
+			if now.After(t) {
+				delete(s.expiry, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	if t, ok := s.expiry[id]; ok && !now.After(t) {
+		return false
+	}
+	s.expiry[id] = now.Add(eventTTL)
+	return true
+}
+
+// release forgets a claimed event ID, after a failed dispatch,
+// so that Slack's next retry of the same event will be dispatched.
+func (s *seenEvents) release(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.expiry, id)
+}
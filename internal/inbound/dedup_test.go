@@ -0,0 +1,36 @@
+package inbound
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSeenEvents(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	s := &seenEvents{now: func() time.Time { return now }}
+
+	if !s.claim("E1") {
+		t.Fatal("claim(E1) = false, want true")
+	}
+	if s.claim("E1") {
+		t.Error("second claim(E1) = true, want false")
+	}
+	if !s.claim("E2") {
+		t.Error("claim(E2) = false, want true")
+	}
+
+	// Failed dispatches can be retried.
+	s.release("E2")
+	if !s.claim("E2") {
+		t.Error("claim(E2) after release = false, want true")
+	}
+
+	// Expired event IDs can be claimed again, and are eventually removed.
+	now = now.Add(eventTTL + time.Second)
+	if !s.claim("E1") {
+		t.Error("claim(E1) after TTL = false, want true")
+	}
+	if _, ok := s.expiry["E2"]; ok {
+		t.Error("expired event ID E2 wasn't removed")
+	}
+}
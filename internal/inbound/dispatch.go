@@ -0,0 +1,106 @@
+package inbound
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"go.temporal.io/sdk/client"
+)
+
+// temporalClient is the subset of the Temporal client
+// that dispatchers use, to facilitate testing.
+type temporalClient interface {
+	ExecuteWorkflow(ctx context.Context, options client.StartWorkflowOptions, workflow any, args ...any) (client.WorkflowRun, error)
+	SignalWorkflow(ctx context.Context, workflowID, runID, signalName string, arg any) error
+	SignalWithStartWorkflow(ctx context.Context, workflowID, signalName string, signalArg any,
+		options client.StartWorkflowOptions, workflow any, workflowArgs ...any) (client.WorkflowRun, error)
+	UpdateWorkflow(ctx context.Context, options client.UpdateWorkflowOptions) (client.WorkflowUpdateHandle, error)
+}
+
+// dispatcher starts and signals Temporal workflows in response to inbound Slack requests.
+type dispatcher struct {
+	client    temporalClient
+	taskQueue string // Default, unless specified in the route.
+}
+
+// route dispatches a payload according to the route of the given key (e.g. event
+// type) in a routing table, if there is one. The workflow ID is expanded from the
+// route's template, or defaults to the given ID if the route doesn't specify one.
+func (d *dispatcher) route(ctx context.Context, routes map[string]Route, key, defaultID string, values map[string]string, payload any) error {
+	l := log.With().Str("route", key).Logger()
+
+	r, ok := routes[key]
+	if !ok {
+		l.Debug().Msg("no route for inbound Slack request")
+		return nil
+	}
+
+	id := defaultID
+	if r.WorkflowID != "" {
+		id = expandWorkflowID(r.WorkflowID, values)
+	}
+
+	return d.send(ctx, key, r, id, payload)
+}
+
+// send dispatches a payload according to a route, with a timeout
+// that ensures a timely response to Slack, and logs the result.
+func (d *dispatcher) send(ctx context.Context, key string, r Route, workflowID string, payload any) error {
+	l := log.With().Str("route", key).Logger()
+
+	ctx, cancel := context.WithTimeout(ctx, dispatchTimeout)
+	defer cancel()
+
+	id, err := d.dispatch(ctx, r, workflowID, payload)
+	if err != nil {
+		l.Error().Err(err).Str("workflow_id", workflowID).Msg("failed to dispatch inbound Slack request")
+		return err
+	}
+
+	l.Info().Str("workflow_id", id).Msg("dispatched inbound Slack request")
+	return nil
+}
+
+// dispatch starts, signals or updates a workflow according to the given route,
+// with the given payload as the workflow's input or the signal's or update's
+// argument. It returns the ID of the workflow that received the payload.
+func (d *dispatcher) dispatch(ctx context.Context, r Route, workflowID string, payload any) (string, error) {
+	if r.Update != "" {
+		// Don't wait for the update to complete, only for the workflow to accept it.
+		_, err := d.client.UpdateWorkflow(ctx, client.UpdateWorkflowOptions{
+			WorkflowID:   workflowID,
+			UpdateName:   r.Update,
+			Args:         []any{payload},
+			WaitForStage: client.WorkflowUpdateStageAccepted,
+		})
+		return workflowID, err
+	}
+
+	if r.Workflow != "" && r.Signal == "" {
+		opts := d.startOptions(r, workflowID)
+		run, err := d.client.ExecuteWorkflow(ctx, opts, r.Workflow, payload)
+		if err != nil {
+			return "", err
+		}
+		return run.GetID(), nil
+	}
+
+	if r.Workflow == "" {
+		return workflowID, d.client.SignalWorkflow(ctx, workflowID, "", r.Signal, payload)
+	}
+
+	opts := d.startOptions(r, workflowID)
+	run, err := d.client.SignalWithStartWorkflow(ctx, workflowID, r.Signal, payload, opts, r.Workflow)
+	if err != nil {
+		return "", err
+	}
+	return run.GetID(), nil
+}
+
+func (d *dispatcher) startOptions(r Route, workflowID string) client.StartWorkflowOptions {
+	opts := client.StartWorkflowOptions{ID: workflowID, TaskQueue: r.TaskQueue}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = d.taskQueue
+	}
+	return opts
+}
@@ -0,0 +1,126 @@
+package inbound
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/tzrikka/ovid/pkg/slack"
+)
+
+const (
+	// Slack expects a response within 3 seconds.
+	dispatchTimeout = 2 * time.Second
+	maxBodySize     = 1 << 20 // 1 MiB.
+)
+
+// eventsHandler receives Slack Events API requests:
+// https://docs.slack.dev/apis/events-api/using-http-request-urls
+func (s *server) eventsHandler(w http.ResponseWriter, r *http.Request) {
+	body, ok := s.verifiedBody(w, r)
+	if !ok {
+		return
+	}
+
+	e := new(slack.EventCallback)
+	if err := json.Unmarshal(body, e); err != nil {
+		log.Warn().Err(err).Msg("failed to decode Slack event")
+		http.Error(w, "invalid JSON body", http.StatusBadRequest)
+		return
+	}
+
+	switch e.Type {
+	case "url_verification":
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(e.Challenge))
+	case "event_callback":
+		s.dispatchEvent(r.Context(), w, e)
+	default:
+		log.Debug().Str("type", e.Type).Msg("ignoring Slack Events API request")
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// verifiedBody reads the body of an inbound Slack request and verifies its signature.
+// If it fails, it also writes an error response, and the caller should stop.
+func (s *server) verifiedBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return nil, false
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to read Slack request body")
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return nil, false
+	}
+
+	secret, err := s.signingSecret(r.Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get Slack signing secret")
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if err := verifySignature(secret, r.Header, body, time.Now()); err != nil {
+		log.Warn().Err(err).Str("remote_addr", r.RemoteAddr).Msg("rejected Slack request")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return nil, false
+	}
+
+	return body, true
+}
+
+// dispatchEvent acknowledges an event after routing it. Routing errors result in
+// an HTTP 500 response, so that Slack will retry sending the event later.
+func (s *server) dispatchEvent(ctx context.Context, w http.ResponseWriter, e *slack.EventCallback) {
+	if err := s.routeEvent(ctx, e); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// routeEvent starts or signals a Temporal workflow based on the event type.
+// Events without a route are ignored. This is used by all inbound transports.
+//
+// Events which Slack redelivers after they were already dispatched (or while they
+// are being dispatched) are ignored too, because signals are not idempotent.
+func (s *server) routeEvent(ctx context.Context, e *slack.EventCallback) error {
+	if e.EventID != "" && !s.events.claim(e.EventID) {
+		log.Debug().Str("event_id", e.EventID).Msg("ignoring redelivered Slack event")
+		return nil
+	}
+
+	e.Token = ""
+	err := s.dispatcher.route(ctx, s.routes.Events, e.EventType(), "slack-event-"+e.EventID, eventValues(e), e)
+	if err != nil && e.EventID != "" {
+		s.events.release(e.EventID)
+	}
+	return err
+}
+
+// eventValues returns the values which may be used in
+// workflow ID templates in the routing table of events.
+func eventValues(e *slack.EventCallback) map[string]string {
+	vs := map[string]string{
+		"event_type": e.EventType(),
+		"event_id":   e.EventID,
+		"team_id":    e.TeamID,
+	}
+	for _, k := range []string{"channel", "user", "ts", "thread_ts", "event_ts"} {
+		vs[k] = e.EventField(k)
+	}
+
+	// Some events (e.g. "reaction_added") refer to a message in an "item".
+	if item, ok := e.Event["item"].(map[string]any); ok && vs["channel"] == "" {
+		vs["channel"], _ = item["channel"].(string)
+	}
+
+	return vs
+}
@@ -0,0 +1,208 @@
+package inbound
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/tzrikka/ovid/pkg/slack"
+)
+
+// fakeClient records the last workflow start or signal, instead of calling Temporal.
+type fakeClient struct {
+	mu    sync.Mutex
+	last  fakeDispatch
+	count int
+}
+
+type fakeDispatch struct {
+	workflow, workflowID, signal, update, taskQueue string
+	arg                                             any
+}
+
+func (f *fakeClient) ExecuteWorkflow(_ context.Context, opts client.StartWorkflowOptions, workflow any, args ...any) (client.WorkflowRun, error) {
+	f.record(fakeDispatch{workflow: workflow.(string), workflowID: opts.ID, taskQueue: opts.TaskQueue, arg: args[0]})
+	return fakeRun{id: opts.ID}, nil
+}
+
+func (f *fakeClient) SignalWorkflow(_ context.Context, workflowID, _, signalName string, arg any) error {
+	f.record(fakeDispatch{workflowID: workflowID, signal: signalName, arg: arg})
+	return nil
+}
+
+func (f *fakeClient) SignalWithStartWorkflow(_ context.Context, workflowID, signalName string, signalArg any,
+	opts client.StartWorkflowOptions, workflow any, _ ...any,
+) (client.WorkflowRun, error) {
+	f.record(fakeDispatch{workflow: workflow.(string), workflowID: workflowID, signal: signalName, taskQueue: opts.TaskQueue, arg: signalArg})
+	return fakeRun{id: workflowID}, nil
+}
+
+func (f *fakeClient) UpdateWorkflow(_ context.Context, opts client.UpdateWorkflowOptions) (client.WorkflowUpdateHandle, error) {
+	f.record(fakeDispatch{workflowID: opts.WorkflowID, update: opts.UpdateName, arg: opts.Args[0]})
+	return nil, nil
+}
+
+func (f *fakeClient) record(d fakeDispatch) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.last = d
+	f.count++
+}
+
+func (f *fakeClient) dispatches() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.count
+}
+
+func (f *fakeClient) lastDispatch() fakeDispatch {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.last
+}
+
+type fakeRun struct {
+	client.WorkflowRun
+	id string
+}
+
+func (r fakeRun) GetID() string {
+	return r.id
+}
+
+func newTestServer(routes Routes) (*server, *fakeClient) {
+	c := &fakeClient{}
+	s := &server{
+		routes:     &routes,
+		dispatcher: &dispatcher{client: c, taskQueue: "default"},
+		secret:     "secret",
+	}
+	return s, c
+}
+
+func TestEventsHandler(t *testing.T) {
+	routes := Routes{Events: map[string]Route{
+		"app_mention":    {Workflow: "mention"},
+		"reaction_added": {Workflow: "reactions", Signal: "reaction", TaskQueue: "bots", WorkflowID: "r-{channel}-{user}"},
+		"member_joined":  {Signal: "joined", WorkflowID: "channel-{channel}"},
+	}}
+
+	tests := []struct {
+		name         string
+		body         string
+		unsigned     bool
+		wantStatus   int
+		wantBody     string
+		wantWorkflow string
+		wantID       string
+		wantSignal   string
+		wantQueue    string
+	}{
+		{
+			name:       "unsigned",
+			body:       `{"type":"url_verification","challenge":"abc"}`,
+			unsigned:   true,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "url_verification",
+			body:       `{"type":"url_verification","challenge":"abc"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   "abc",
+		},
+		{
+			name:       "invalid_json",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "no_route",
+			body:       `{"type":"event_callback","event_id":"E1","event":{"type":"message"}}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:         "start",
+			body:         `{"type":"event_callback","token":"t","event_id":"E1","event":{"type":"app_mention","channel":"C1"}}`,
+			wantStatus:   http.StatusOK,
+			wantWorkflow: "mention",
+			wantID:       "slack-event-E1",
+			wantQueue:    "default",
+		},
+		{
+			name:         "signal_with_start",
+			body:         `{"type":"event_callback","event_id":"E2","event":{"type":"reaction_added","user":"U1","item":{"channel":"C2"}}}`,
+			wantStatus:   http.StatusOK,
+			wantWorkflow: "reactions",
+			wantID:       "r-C2-U1",
+			wantSignal:   "reaction",
+			wantQueue:    "bots",
+		},
+		{
+			name:       "signal",
+			body:       `{"type":"event_callback","event_id":"E3","event":{"type":"member_joined","channel":"C3"}}`,
+			wantStatus: http.StatusOK,
+			wantID:     "channel-C3",
+			wantSignal: "joined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, c := newTestServer(routes)
+
+			body := []byte(tt.body)
+			r := httptest.NewRequest(http.MethodPost, "/slack/events", bytes.NewReader(body))
+			if !tt.unsigned {
+				r.Header = signedHeader("secret", body)
+			}
+			w := httptest.NewRecorder()
+
+			s.mux().ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if tt.wantBody != "" && w.Body.String() != tt.wantBody {
+				t.Errorf("body = %q, want %q", w.Body.String(), tt.wantBody)
+			}
+			d := c.lastDispatch()
+			if d.workflow != tt.wantWorkflow || d.workflowID != tt.wantID || d.signal != tt.wantSignal || d.taskQueue != tt.wantQueue {
+				t.Errorf("dispatched (workflow %q, ID %q, signal %q, queue %q), want (%q, %q, %q, %q)",
+					d.workflow, d.workflowID, d.signal, d.taskQueue, tt.wantWorkflow, tt.wantID, tt.wantSignal, tt.wantQueue)
+			}
+			if e, ok := d.arg.(*slack.EventCallback); ok && e.Token != "" {
+				t.Errorf("dispatched event with verification token %q", e.Token)
+			}
+		})
+	}
+}
+
+func TestEventsHandlerRetries(t *testing.T) {
+	s, c := newTestServer(Routes{Events: map[string]Route{
+		"member_joined": {Signal: "joined", WorkflowID: "channel-{channel}"},
+	}})
+
+	body := []byte(`{"type":"event_callback","event_id":"E1","event":{"type":"member_joined","channel":"C1"}}`)
+	for i := range 2 {
+		r := httptest.NewRequest(http.MethodPost, "/slack/events", bytes.NewReader(body))
+		r.Header = signedHeader("secret", body)
+		r.Header.Set("X-Slack-Retry-Num", "1")
+		r.Header.Set("X-Slack-Retry-Reason", "http_timeout")
+		w := httptest.NewRecorder()
+
+		s.mux().ServeHTTP(w, r)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("request %d: status = %d, want %d", i+1, w.Code, http.StatusOK)
+		}
+	}
+
+	if got := c.dispatches(); got != 1 {
+		t.Errorf("dispatched %d signals, want 1", got)
+	}
+}
@@ -0,0 +1,40 @@
+package inbound
+
+import (
+	altsrc "github.com/urfave/cli-altsrc/v3"
+	"github.com/urfave/cli-altsrc/v3/toml"
+	"github.com/urfave/cli/v3"
+)
+
+// Flags defines CLI flags to configure Ovid's receiver of inbound Slack requests.
+// These flags can also be set using environment variables and the application's
+// configuration file.
+func Flags(configFilePath altsrc.StringSourcer) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "slack-http-addr",
+			Usage: "local address to receive Slack HTTP requests (disabled if empty)",
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("SLACK_HTTP_ADDRESS"),
+				toml.TOML("slack.http.address", configFilePath),
+			),
+		},
+		&cli.BoolFlag{
+			Name:  "slack-socket-mode",
+			Usage: "receive Slack requests over a Socket Mode WebSocket connection",
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("SLACK_SOCKET_MODE"),
+				toml.TOML("slack.socket_mode", configFilePath),
+			),
+		},
+		&cli.StringFlag{
+			Name:  "slack-routes-file",
+			Usage: "TOML file with routing tables for inbound Slack requests",
+			Value: configFilePath.SourceURI(),
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("SLACK_ROUTES_FILE"),
+			),
+			TakesFile: true,
+		},
+	}
+}
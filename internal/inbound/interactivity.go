@@ -0,0 +1,164 @@
+package inbound
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/tzrikka/ovid/pkg/slack"
+)
+
+// interactivityHandler receives interaction payloads, and acknowledges them
+// after routing them (Slack requires that within 3 seconds). Routing errors
+// result in an HTTP 500 response, which Slack displays to the user.
+//
+// https://docs.slack.dev/interactivity/handling-user-interaction
+func (s *server) interactivityHandler(w http.ResponseWriter, r *http.Request) {
+	form, ok := s.verifiedForm(w, r)
+	if !ok {
+		return
+	}
+
+	p := new(slack.InteractionPayload)
+	if err := json.Unmarshal([]byte(form.Get("payload")), p); err != nil {
+		log.Warn().Err(err).Msg("failed to decode Slack interaction payload")
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.routeInteraction(r.Context(), p); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// commandsHandler receives slash commands, and acknowledges them after routing
+// them (Slack requires that within 3 seconds). Routing errors result in an
+// HTTP 500 response, which Slack displays to the user.
+//
+// https://docs.slack.dev/interactivity/implementing-slash-commands
+func (s *server) commandsHandler(w http.ResponseWriter, r *http.Request) {
+	form, ok := s.verifiedForm(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := slashCommand(form)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to decode Slack slash command")
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.routeCommand(r.Context(), c); err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// verifiedForm reads the URL-encoded form in the body of an inbound Slack request,
+// after verifying its signature. If it fails, it also writes an error response.
+func (s *server) verifiedForm(w http.ResponseWriter, r *http.Request) (url.Values, bool) {
+	body, ok := s.verifiedBody(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to parse Slack request form")
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return nil, false
+	}
+
+	return form, true
+}
+
+// slashCommand converts a URL-encoded form into a [slack.SlashCommand].
+// Socket Mode sends the same fields as a JSON object.
+func slashCommand(form url.Values) (*slack.SlashCommand, error) {
+	m := make(map[string]string, len(form))
+	for k := range form {
+		m[k] = form.Get(k)
+	}
+
+	j, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	c := new(slack.SlashCommand)
+	if err := json.Unmarshal(j, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// routeInteraction delivers an interaction payload to the workflow encoded
+// in an action ID or a view's callback ID, if there is one (see
+// [slack.WorkflowTarget]). Otherwise, it starts or signals a workflow
+// based on the payload type (e.g. "block_actions", "view_submission").
+// Payloads without a target or a route are ignored.
+func (s *server) routeInteraction(ctx context.Context, p *slack.InteractionPayload) error {
+	p.Token = ""
+
+	if t, ok := interactionTarget(p); ok {
+		r := Route{Signal: t.Signal, Update: t.Update}
+		return s.dispatcher.send(ctx, p.Type, r, t.WorkflowID, p)
+	}
+
+	vs := map[string]string{
+		"type":       p.Type,
+		"trigger_id": p.TriggerID,
+	}
+	if p.Team != nil {
+		vs["team_id"] = p.Team.ID
+	}
+	if p.User != nil {
+		vs["user"] = p.User.ID
+	}
+	if p.Channel != nil {
+		vs["channel"] = p.Channel.ID
+	}
+	if p.View != nil {
+		vs["callback_id"] = p.View.CallbackID
+	}
+
+	return s.dispatcher.route(ctx, s.routes.Interactivity, p.Type, "slack-interaction-"+p.TriggerID, vs, p)
+}
+
+// interactionTarget returns the first [slack.WorkflowTarget] which is encoded in
+// the action IDs of a "block_actions" payload, or in the callback ID of a view.
+func interactionTarget(p *slack.InteractionPayload) (slack.WorkflowTarget, bool) {
+	for _, a := range p.Actions {
+		if t, ok := slack.ParseWorkflowTarget(a.ActionID); ok {
+			return t, true
+		}
+	}
+
+	if p.View != nil {
+		return slack.ParseWorkflowTarget(p.View.CallbackID)
+	}
+
+	return slack.WorkflowTarget{}, false
+}
+
+// routeCommand starts or signals a Temporal workflow based on
+// the name of a slash command. Commands without a route are ignored.
+func (s *server) routeCommand(ctx context.Context, c *slack.SlashCommand) error {
+	c.Token = ""
+	vs := map[string]string{
+		"command":    c.Command,
+		"trigger_id": c.TriggerID,
+		"team_id":    c.TeamID,
+		"user":       c.UserID,
+		"channel":    c.ChannelID,
+	}
+	return s.dispatcher.route(ctx, s.routes.Commands, c.Command, "slack-command-"+c.TriggerID, vs, c)
+}
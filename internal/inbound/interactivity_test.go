@@ -0,0 +1,125 @@
+package inbound
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/tzrikka/ovid/pkg/slack"
+)
+
+func TestInteractivityHandler(t *testing.T) {
+	routes := Routes{Interactivity: map[string]Route{
+		"block_actions":   {Workflow: "actions", WorkflowID: "actions-{user}"},
+		"view_submission": {Signal: "submitted", WorkflowID: "modal-{callback_id}"},
+	}}
+
+	approve := slack.WorkflowTarget{WorkflowID: "approval-123", Signal: "decision", Tag: "approve"}
+	edit := slack.WorkflowTarget{WorkflowID: "doc:42", Update: "edit"}
+
+	tests := []struct {
+		name       string
+		payload    string
+		wantStatus int
+		want       fakeDispatch
+	}{
+		{
+			name:       "invalid_payload",
+			payload:    `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "signal_target",
+			payload:    `{"type":"block_actions","user":{"id":"U1"},"actions":[{"type":"button","action_id":"` + approve.String() + `","value":"yes"}]}`,
+			wantStatus: http.StatusOK,
+			want:       fakeDispatch{workflowID: "approval-123", signal: "decision"},
+		},
+		{
+			name:       "update_target",
+			payload:    `{"type":"view_submission","view":{"type":"modal","callback_id":"` + edit.String() + `","blocks":[]}}`,
+			wantStatus: http.StatusOK,
+			want:       fakeDispatch{workflowID: "doc:42", update: "edit"},
+		},
+		{
+			name:       "route_without_target",
+			payload:    `{"type":"block_actions","user":{"id":"U1"},"actions":[{"type":"button","action_id":"plain"}]}`,
+			wantStatus: http.StatusOK,
+			want:       fakeDispatch{workflow: "actions", workflowID: "actions-U1", taskQueue: "default"},
+		},
+		{
+			name:       "route_view_callback_id",
+			payload:    `{"type":"view_submission","view":{"type":"modal","callback_id":"survey","blocks":[]}}`,
+			wantStatus: http.StatusOK,
+			want:       fakeDispatch{workflowID: "modal-survey", signal: "submitted"},
+		},
+		{
+			name:       "no_route",
+			payload:    `{"type":"shortcut","trigger_id":"T1"}`,
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, c := newTestServer(routes)
+
+			body := []byte(url.Values{"payload": {tt.payload}}.Encode())
+			r := httptest.NewRequest(http.MethodPost, "/slack/interactivity", strings.NewReader(string(body)))
+			r.Header = signedHeader("secret", body)
+			w := httptest.NewRecorder()
+
+			s.mux().ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			got := c.lastDispatch()
+			if p, ok := got.arg.(*slack.InteractionPayload); ok {
+				if p.Type == "" {
+					t.Error("dispatched payload without a type")
+				}
+				got.arg = nil
+			}
+			if got != tt.want {
+				t.Errorf("dispatched %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommandsHandler(t *testing.T) {
+	s, c := newTestServer(Routes{Commands: map[string]Route{
+		"/deploy": {Workflow: "deploy", WorkflowID: "deploy-{channel}"},
+	}})
+
+	form := url.Values{
+		"command":    {"/deploy"},
+		"text":       {"prod"},
+		"token":      {"secret-token"},
+		"channel_id": {"C1"},
+		"user_id":    {"U1"},
+		"trigger_id": {"T1"},
+	}
+	body := []byte(form.Encode())
+	r := httptest.NewRequest(http.MethodPost, "/slack/commands", strings.NewReader(string(body)))
+	r.Header = signedHeader("secret", body)
+	w := httptest.NewRecorder()
+
+	s.mux().ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	got := c.lastDispatch()
+	if got.workflow != "deploy" || got.workflowID != "deploy-C1" {
+		t.Errorf("dispatched to workflow %q with ID %q, want %q and %q", got.workflow, got.workflowID, "deploy", "deploy-C1")
+	}
+
+	want := &slack.SlashCommand{Command: "/deploy", Text: "prod", ChannelID: "C1", UserID: "U1", TriggerID: "T1"}
+	if cmd, ok := got.arg.(*slack.SlashCommand); !ok || *cmd != *want {
+		t.Errorf("dispatched payload = %+v, want %+v", got.arg, want)
+	}
+}
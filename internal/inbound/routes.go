@@ -0,0 +1,127 @@
+package inbound
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Route specifies how to dispatch a specific type of inbound Slack request to Temporal:
+//   - Workflow only: start a new workflow, with the Slack payload as its input
+//   - Workflow and Signal: signal-with-start a workflow, with the payload as the signal
+//   - Signal only: signal an existing workflow, with the payload as the signal
+//   - Update only: update an existing workflow, with the payload as the update
+//
+// WorkflowID is a template which may contain placeholders such as "{channel}" or
+// "{user}", which are replaced with values from the Slack payload. It is optional
+// when starting new workflows, and required when signaling or updating them.
+type Route struct {
+	Workflow   string `toml:"workflow"`
+	Signal     string `toml:"signal"`
+	Update     string `toml:"update"`
+	TaskQueue  string `toml:"task_queue"`
+	WorkflowID string `toml:"workflow_id"`
+}
+
+// Routes are the routing tables of inbound Slack requests: events are keyed by
+// their type (e.g. "app_mention"), interactive payloads by their type (e.g.
+// "block_actions"), and slash commands by their name (e.g. "/deploy").
+type Routes struct {
+	Events        map[string]Route `toml:"events"`
+	Interactivity map[string]Route `toml:"interactivity"`
+	Commands      map[string]Route `toml:"commands"`
+}
+
+// loadRoutes parses the "slack.routes" section of a TOML file, e.g.:
+//
+//	[slack.routes.events.app_mention]
+//	workflow = "handle-mention"
+//	workflow_id = "mention-{channel}-{ts}"
+//
+//	[slack.routes.events.reaction_added]
+//	workflow = "reaction-tracker"
+//	signal = "reaction"
+//	task_queue = "bots"
+//	workflow_id = "reactions-{user}"
+//
+//	[slack.routes.commands."/deploy"]
+//	workflow = "deploy"
+func loadRoutes(path string) (*Routes, error) {
+	var cfg struct {
+		Slack struct {
+			Routes Routes `toml:"routes"`
+		} `toml:"slack"`
+	}
+
+	if path != "" {
+		b, err := os.ReadFile(path) //gosec:disable G304 -- user-specified file by design
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Slack routes file: %w", err)
+		}
+		if _, err := toml.Decode(string(b), &cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse Slack routes file: %w", err)
+		}
+	}
+
+	rs := &cfg.Slack.Routes
+	tables := map[string]map[string]Route{
+		"event":               rs.Events,
+		"interactive payload": rs.Interactivity,
+		"slash command":       rs.Commands,
+	}
+	for kind, routes := range tables {
+		for key, r := range routes {
+			if err := r.validate(); err != nil {
+				return nil, fmt.Errorf("invalid route for Slack %s %q: %w", kind, key, err)
+			}
+		}
+	}
+
+	return rs, nil
+}
+
+func (r Route) validate() error {
+	if r.Update != "" {
+		if r.Workflow != "" || r.Signal != "" {
+			return errors.New("updates are mutually exclusive with workflow and signal names")
+		}
+		if r.WorkflowID == "" {
+			return errors.New("updates require a workflow ID template")
+		}
+		return nil
+	}
+
+	if r.Workflow == "" && r.Signal == "" {
+		return errors.New("missing workflow, signal and update names")
+	}
+	if r.Signal != "" && r.WorkflowID == "" {
+		return errors.New("signals require a workflow ID template")
+	}
+	return nil
+}
+
+// expandWorkflowID replaces "{name}" placeholders in a workflow ID template with
+// values from the given map. Unknown placeholders are replaced with empty strings.
+func expandWorkflowID(template string, values map[string]string) string {
+	var sb strings.Builder
+	for {
+		start := strings.Index(template, "{")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(template[start:], "}")
+		if end < 0 {
+			break
+		}
+
+		sb.WriteString(template[:start])
+		sb.WriteString(values[template[start+1:start+end]])
+		template = template[start+end+1:]
+	}
+
+	sb.WriteString(template)
+	return sb.String()
+}
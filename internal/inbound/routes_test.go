@@ -0,0 +1,115 @@
+package inbound
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadRoutes(t *testing.T) {
+	tests := []struct {
+		name    string
+		toml    string
+		want    map[string]Route
+		wantErr bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid",
+			toml: `
+				[temporal]
+				task_queue = "ignored"
+
+				[slack.routes.events.app_mention]
+				workflow = "handle-mention"
+
+				[slack.routes.events.reaction_added]
+				workflow = "reactions"
+				signal = "reaction"
+				task_queue = "bots"
+				workflow_id = "reactions-{user}"
+			`,
+			want: map[string]Route{
+				"app_mention":    {Workflow: "handle-mention"},
+				"reaction_added": {Workflow: "reactions", Signal: "reaction", TaskQueue: "bots", WorkflowID: "reactions-{user}"},
+			},
+		},
+		{
+			name: "missing_names",
+			toml: `
+				[slack.routes.events.app_mention]
+				task_queue = "bots"
+			`,
+			wantErr: true,
+		},
+		{
+			name: "update_with_signal",
+			toml: `
+				[slack.routes.interactivity.view_submission]
+				update = "submit"
+				signal = "submit"
+				workflow_id = "modal-{callback_id}"
+			`,
+			wantErr: true,
+		},
+		{
+			name: "update_without_workflow_id",
+			toml: `
+				[slack.routes.interactivity.view_submission]
+				update = "submit"
+			`,
+			wantErr: true,
+		},
+		{
+			name: "signal_without_workflow_id",
+			toml: `
+				[slack.routes.events.app_mention]
+				signal = "mention"
+			`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.toml")
+			if err := os.WriteFile(path, []byte(tt.toml), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := loadRoutes(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loadRoutes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got.Events, tt.want) {
+				t.Errorf("loadRoutes() = %v, want %v", got.Events, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandWorkflowID(t *testing.T) {
+	values := map[string]string{"channel": "C123", "ts": "1.2"}
+
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{"static", "static"},
+		{"{channel}", "C123"},
+		{"slack-{channel}-{ts}", "slack-C123-1.2"},
+		{"unknown-{foo}", "unknown-"},
+		{"unclosed-{channel", "unclosed-{channel"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			if got := expandWorkflowID(tt.template, values); got != tt.want {
+				t.Errorf("expandWorkflowID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
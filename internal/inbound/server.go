@@ -0,0 +1,132 @@
+// Package inbound receives requests from Slack, and dispatches
+// them to Temporal workflows according to a routing table.
+package inbound
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/urfave/cli/v3"
+	"go.temporal.io/sdk/client"
+
+	"github.com/tzrikka/ovid/internal/thrippy"
+	httpclient "github.com/tzrikka/ovid/pkg/client"
+)
+
+const (
+	readHeaderTimeout = 5 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+type server struct {
+	thrippy    thrippy.LinkClient
+	httpClient *httpclient.Client
+	routes     *Routes
+	dispatcher *dispatcher
+	events     seenEvents
+
+	mu     sync.Mutex
+	secret string
+}
+
+// Start runs the configured receivers of inbound Slack requests in the background:
+// an HTTP server for Slack events, interactivity and slash commands, and/or a
+// Socket Mode client, which uses the given HTTP client to open connections.
+// It returns a function to stop them.
+func Start(cmd *cli.Command, c client.Client, hc *httpclient.Client) (func(), error) {
+	addr := cmd.String("slack-http-addr")
+	socket := cmd.Bool("slack-socket-mode")
+	if addr == "" && !socket {
+		return func() {}, nil
+	}
+
+	routes, err := loadRoutes(cmd.String("slack-routes-file"))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &server{
+		thrippy:    thrippy.NewLinkClient(cmd.String("thrippy-link-slack"), cmd),
+		httpClient: hc,
+		routes:     routes,
+		dispatcher: &dispatcher{client: c, taskQueue: cmd.String("temporal-task-queue")},
+	}
+
+	var stops []func()
+	if addr != "" {
+		stop, err := s.startHTTP(addr)
+		if err != nil {
+			return nil, err
+		}
+		stops = append(stops, stop)
+	}
+	if socket {
+		stops = append(stops, newSocketMode(s).start())
+	}
+
+	return func() {
+		for _, stop := range stops {
+			stop()
+		}
+	}, nil
+}
+
+// startHTTP runs an HTTP server in the background, and returns a function to stop it.
+// Slack should be configured to send requests to these paths: "/slack/events",
+// "/slack/interactivity" and "/slack/commands".
+func (s *server) startHTTP(addr string) (func(), error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	hs := &http.Server{Handler: s.mux(), ReadHeaderTimeout: readHeaderTimeout}
+	go func() {
+		log.Info().Str("address", lis.Addr().String()).Msg("receiving Slack requests")
+		if err := hs.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Msg("Slack HTTP server error")
+		}
+	}()
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		_ = hs.Shutdown(ctx)
+	}, nil
+}
+
+func (s *server) mux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/slack/events", s.eventsHandler)
+	mux.HandleFunc("/slack/interactivity", s.interactivityHandler)
+	mux.HandleFunc("/slack/commands", s.commandsHandler)
+	return mux
+}
+
+// signingSecret returns the Slack app's signing secret from the Thrippy
+// link's credentials. It is fetched once, and then cached in memory.
+func (s *server) signingSecret(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.secret != "" {
+		return s.secret, nil
+	}
+
+	creds, err := s.thrippy.LinkCreds(ctx, "slack")
+	if err != nil {
+		return "", err
+	}
+
+	s.secret = creds["signing_secret"]
+	if s.secret == "" {
+		return "", errors.New("Slack signing secret not found in Thrippy link credentials")
+	}
+
+	return s.secret, nil
+}
@@ -0,0 +1,62 @@
+package inbound
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	signatureHeader = "X-Slack-Signature"
+	timestampHeader = "X-Slack-Request-Timestamp"
+	signatureScheme = "v0"
+
+	// maxTimestampSkew protects against replay attacks.
+	maxTimestampSkew = 5 * time.Minute
+)
+
+// verifySignature checks the authenticity of an inbound Slack request, based on
+// its HMAC-SHA256 signature and the Slack app's signing secret, as well as the
+// freshness of its timestamp: https://docs.slack.dev/authentication/verifying-requests-from-slack
+func verifySignature(secret string, h http.Header, body []byte, now time.Time) error {
+	ts := h.Get(timestampHeader)
+	if ts == "" {
+		return errors.New("missing timestamp header")
+	}
+	secs, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp header: %q", ts)
+	}
+	if skew := now.Sub(time.Unix(secs, 0)).Abs(); skew > maxTimestampSkew {
+		return fmt.Errorf("timestamp skew too large: %s", skew)
+	}
+
+	sig, found := strings.CutPrefix(h.Get(signatureHeader), signatureScheme+"=")
+	if !found {
+		return errors.New("missing or invalid signature header")
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return errors.New("invalid signature encoding")
+	}
+
+	if !hmac.Equal(got, signature(secret, ts, body)) {
+		return errors.New("signature mismatch")
+	}
+
+	return nil
+}
+
+// signature computes the expected HMAC-SHA256 signature of an inbound Slack request.
+func signature(secret, ts string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signatureScheme + ":" + ts + ":"))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
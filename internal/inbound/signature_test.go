@@ -0,0 +1,104 @@
+package inbound
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestVerifySignature(t *testing.T) {
+	// https://docs.slack.dev/authentication/verifying-requests-from-slack#a-recipe-for-security
+	secret := "8f742231b10e8888abcd99yyyzzz85a5"
+	ts := "1531420618"
+	body := []byte("token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&team_domain=testteamnow&channel_id=G8PSS9T3V&channel_name=foobar&user_id=U2CERLKJA&user_name=roadrunner&command=%2Fwebhook-collect&text=&response_url=https%3A%2F%2Fhooks.slack.com%2Fcommands%2FT1DC2JH3J%2F397700885554%2F96rGlfmibIGlgcZRskXaIFfN&trigger_id=398738663015.47445629121.803a0bc887a14d10d2c447fce8b6703c")
+	validSig := "v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503"
+	now := time.Unix(1531420618, 0)
+
+	tests := []struct {
+		name    string
+		ts      string
+		sig     string
+		now     time.Time
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			ts:   ts,
+			sig:  validSig,
+			now:  now,
+		},
+		{
+			name: "small_skew",
+			ts:   ts,
+			sig:  validSig,
+			now:  now.Add(maxTimestampSkew),
+		},
+		{
+			name:    "old_timestamp",
+			ts:      ts,
+			sig:     validSig,
+			now:     now.Add(maxTimestampSkew + time.Second),
+			wantErr: true,
+		},
+		{
+			name:    "future_timestamp",
+			ts:      ts,
+			sig:     validSig,
+			now:     now.Add(-maxTimestampSkew - time.Second),
+			wantErr: true,
+		},
+		{
+			name:    "missing_timestamp",
+			sig:     validSig,
+			now:     now,
+			wantErr: true,
+		},
+		{
+			name:    "missing_signature",
+			ts:      ts,
+			now:     now,
+			wantErr: true,
+		},
+		{
+			name:    "wrong_scheme",
+			ts:      ts,
+			sig:     "v1=" + validSig[3:],
+			now:     now,
+			wantErr: true,
+		},
+		{
+			name:    "wrong_signature",
+			ts:      ts,
+			sig:     "v0=" + hex.EncodeToString(make([]byte, 32)),
+			now:     now,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.Header{}
+			if tt.ts != "" {
+				h.Set(timestampHeader, tt.ts)
+			}
+			if tt.sig != "" {
+				h.Set(signatureHeader, tt.sig)
+			}
+
+			if err := verifySignature(secret, h, body, tt.now); (err != nil) != tt.wantErr {
+				t.Errorf("verifySignature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// signedHeader returns HTTP headers with a valid Slack signature, for tests.
+func signedHeader(secret string, body []byte) http.Header {
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	h := http.Header{}
+	h.Set(timestampHeader, ts)
+	h.Set(signatureHeader, signatureScheme+"="+hex.EncodeToString(signature(secret, ts, body)))
+	return h
+}
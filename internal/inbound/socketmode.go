@@ -0,0 +1,276 @@
+package inbound
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+
+	"github.com/tzrikka/ovid/pkg/client"
+	"github.com/tzrikka/ovid/pkg/slack"
+)
+
+const (
+	// Slack sends WebSocket pings periodically, so a long silence means a broken connection.
+	socketReadTimeout  = 2 * time.Minute
+	socketWriteTimeout = 5 * time.Second
+
+	minReconnectDelay = time.Second
+	maxReconnectDelay = time.Minute
+)
+
+// socketMode receives Slack events, interactive payloads and slash commands
+// over a WebSocket connection, instead of an HTTP endpoint. This is useful
+// behind firewalls: https://docs.slack.dev/apis/events-api/using-socket-mode
+type socketMode struct {
+	*server
+
+	// connectionURL returns a new WebSocket URL. By default, it
+	// calls the "apps.connections.open" Slack API method.
+	connectionURL func(ctx context.Context) (string, error)
+	dialer        *websocket.Dialer
+}
+
+// socketEnvelope is the wrapper of all the messages that Slack sends over
+// Socket Mode connections: https://docs.slack.dev/apis/events-api/using-socket-mode#events
+type socketEnvelope struct {
+	Type                   string          `json:"type"`
+	EnvelopeID             string          `json:"envelope_id,omitempty"`
+	Payload                json.RawMessage `json:"payload,omitempty"`
+	AcceptsResponsePayload bool            `json:"accepts_response_payload,omitempty"`
+	RetryAttempt           int             `json:"retry_attempt,omitempty"`
+	RetryReason            string          `json:"retry_reason,omitempty"`
+
+	Reason         string `json:"reason,omitempty"`          // Only in "disconnect".
+	NumConnections int    `json:"num_connections,omitempty"` // Only in "hello".
+}
+
+func newSocketMode(s *server) *socketMode {
+	m := &socketMode{server: s, dialer: newDialer(s.httpClient)}
+	m.connectionURL = m.openConnection
+	return m
+}
+
+// newDialer initializes a WebSocket dialer with the same proxy, TLS configuration
+// and timeout as the given HTTP client, so Socket Mode connections work in the
+// same networks as outbound API requests (e.g. behind firewalls).
+func newDialer(c *client.Client) *websocket.Dialer {
+	d := *websocket.DefaultDialer
+	if c == nil {
+		return &d
+	}
+
+	d.HandshakeTimeout = c.Timeout()
+	if t := c.Transport(); t != nil {
+		d.Proxy = t.Proxy
+		d.TLSClientConfig = t.TLSClientConfig
+	}
+	return &d
+}
+
+// start runs the Socket Mode client in the background,
+// and returns a function to stop it.
+func (m *socketMode) start() func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		m.run(ctx)
+		close(done)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// run maintains a Socket Mode connection until the context is canceled. It
+// reconnects immediately when Slack asks to refresh the connection, and with
+// exponential backoff after errors.
+func (m *socketMode) run(ctx context.Context) {
+	delay := minReconnectDelay
+	for ctx.Err() == nil {
+		err := m.connect(ctx)
+		if err == nil {
+			delay = minReconnectDelay
+			continue
+		}
+
+		log.Error().Err(err).Dur("retry_in", delay).Msg("Slack Socket Mode connection error")
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// connect opens a single WebSocket connection, and handles the messages that it
+// receives until Slack disconnects it, an error occurs, or the context is canceled.
+func (m *socketMode) connect(ctx context.Context) error {
+	wsURL, err := m.connectionURL(ctx)
+	if err != nil {
+		return err
+	}
+
+	conn, _, err := m.dialer.DialContext(ctx, wsURL, nil)
+	if err != nil {
+		return fmt.Errorf("WebSocket dial error: %w", err)
+	}
+	defer conn.Close()
+
+	// Unblock the read loop below when the context is canceled.
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	conn.SetPingHandler(func(data string) error {
+		_ = conn.SetReadDeadline(time.Now().Add(socketReadTimeout))
+		return conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(socketWriteTimeout))
+	})
+
+	sc := &socketConn{conn: conn}
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		_ = conn.SetReadDeadline(time.Now().Add(socketReadTimeout))
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("WebSocket read error: %w", err)
+		}
+
+		env := new(socketEnvelope)
+		if err := json.Unmarshal(msg, env); err != nil {
+			log.Warn().Err(err).Msg("failed to decode Slack Socket Mode message")
+			continue
+		}
+
+		switch env.Type {
+		case "hello":
+			log.Info().Int("num_connections", env.NumConnections).Msg("connected to Slack Socket Mode")
+		case "disconnect":
+			log.Info().Str("reason", env.Reason).Msg("Slack Socket Mode disconnect request")
+			return nil
+		case "events_api", "interactive", "slash_commands":
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				m.handleEnvelope(ctx, sc, env)
+			}()
+		default:
+			log.Debug().Str("type", env.Type).Msg("ignoring Slack Socket Mode message")
+		}
+	}
+}
+
+// handleEnvelope routes the payload of a Socket Mode envelope, and acknowledges
+// it. Routing errors result in no acknowledgement, so that Slack will retry later.
+func (m *socketMode) handleEnvelope(ctx context.Context, sc *socketConn, env *socketEnvelope) {
+	if err := m.routeEnvelope(ctx, env); err != nil {
+		return // Already logged by the dispatcher.
+	}
+
+	if err := sc.ack(env.EnvelopeID); err != nil {
+		log.Error().Err(err).Str("envelope_id", env.EnvelopeID).Msg("failed to acknowledge Slack Socket Mode envelope")
+	}
+}
+
+// routeEnvelope routes the payload of a Socket Mode envelope according to its type.
+// Payloads which can't be decoded are ignored, because retrying them won't help.
+func (m *socketMode) routeEnvelope(ctx context.Context, env *socketEnvelope) error {
+	if env.Type == "events_api" {
+		e := new(slack.EventCallback)
+		if err := json.Unmarshal(env.Payload, e); err != nil {
+			log.Warn().Err(err).Str("type", env.Type).Msg("failed to decode Slack Socket Mode payload")
+			return nil
+		}
+		return m.routeEvent(ctx, e)
+	}
+
+	if env.Type == "interactive" {
+		p := new(slack.InteractionPayload)
+		if err := json.Unmarshal(env.Payload, p); err != nil {
+			log.Warn().Err(err).Str("type", env.Type).Msg("failed to decode Slack Socket Mode payload")
+			return nil
+		}
+		return m.routeInteraction(ctx, p)
+	}
+
+	c := new(slack.SlashCommand)
+	if err := json.Unmarshal(env.Payload, c); err != nil {
+		log.Warn().Err(err).Str("type", env.Type).Msg("failed to decode Slack Socket Mode payload")
+		return nil
+	}
+	return m.routeCommand(ctx, c)
+}
+
+// socketConn serializes writes to a WebSocket connection,
+// because envelopes are handled concurrently.
+type socketConn struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+// ack acknowledges the receipt of a Socket Mode envelope:
+// https://docs.slack.dev/apis/events-api/using-socket-mode#acknowledge
+func (c *socketConn) ack(envelopeID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_ = c.conn.SetWriteDeadline(time.Now().Add(socketWriteTimeout))
+	return c.conn.WriteJSON(map[string]string{"envelope_id": envelopeID})
+}
+
+// openConnection calls the "apps.connections.open" Slack API method with the
+// app-level token from the Thrippy link's credentials, to get a WebSocket URL:
+// https://docs.slack.dev/reference/methods/apps.connections.open
+func (m *socketMode) openConnection(ctx context.Context) (string, error) {
+	template, creds, err := m.thrippy.LinkData(ctx, "slack")
+	if err != nil {
+		return "", err
+	}
+
+	token := creds["app_token"]
+	if token == "" {
+		return "", errors.New("Slack app-level token not found in Thrippy link credentials")
+	}
+
+	apiURL := "https://slack.com/api/apps.connections.open"
+	if template == "slack-oauth-gov" {
+		apiURL = "https://slack-gov.com/api/apps.connections.open"
+	}
+
+	return connectionsOpen(ctx, m.httpClient, apiURL, token)
+}
+
+// connectionsOpen returns a new WebSocket URL from the given Slack API URL.
+func connectionsOpen(ctx context.Context, c *client.Client, apiURL, appToken string) (string, error) {
+	body, err := c.Request(ctx, http.MethodPost, apiURL, appToken, struct{}{})
+	if err != nil {
+		return "", err
+	}
+
+	resp := new(struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error,omitempty"`
+		URL   string `json:"url,omitempty"`
+	})
+	if err := json.Unmarshal(body, resp); err != nil {
+		return "", fmt.Errorf("failed to decode Slack response: %w", err)
+	}
+	if !resp.OK {
+		return "", fmt.Errorf("Slack API error: %s", resp.Error)
+	}
+
+	return resp.URL, nil
+}
@@ -0,0 +1,148 @@
+package inbound
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/tzrikka/ovid/pkg/client"
+)
+
+func TestSocketMode(t *testing.T) {
+	envelopes := []string{
+		`{"type":"events_api","envelope_id":"1","payload":{"type":"event_callback","event_id":"E1","event":{"type":"app_mention"}}}`,
+		`{"type":"interactive","envelope_id":"2","payload":{"type":"block_actions","trigger_id":"T1","user":{"id":"U1"}}}`,
+		`{"type":"slash_commands","envelope_id":"3","payload":{"command":"/deploy","trigger_id":"T2","channel_id":"C1"}}`,
+		`{"type":"events_api","envelope_id":"4","payload":"invalid"}`,
+	}
+
+	var conns atomic.Int32
+	acks := make(chan string, len(envelopes))
+	reconnected := make(chan struct{})
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	mux.HandleFunc("/api/apps.connections.open", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer xapp-token" {
+			t.Errorf("Authorization header = %q", got)
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"url":"ws` + strings.TrimPrefix(srv.URL, "http") + `/ws"}`))
+	})
+	mux.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("Upgrade() error = %v", err)
+			return
+		}
+		defer conn.Close()
+
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"hello","num_connections":1}`))
+		if conns.Add(1) > 1 {
+			close(reconnected)
+			_, _, _ = conn.ReadMessage() // Block until the client closes the connection.
+			return
+		}
+
+		for _, env := range envelopes {
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(env))
+			ack := map[string]string{}
+			if err := conn.ReadJSON(&ack); err != nil {
+				t.Errorf("ReadJSON() error = %v", err)
+				return
+			}
+			acks <- ack["envelope_id"]
+		}
+
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"disconnect","reason":"refresh_requested"}`))
+	})
+
+	s, c := newTestServer(Routes{
+		Events:        map[string]Route{"app_mention": {Workflow: "mention"}},
+		Interactivity: map[string]Route{"block_actions": {Workflow: "actions", WorkflowID: "actions-{user}"}},
+		Commands:      map[string]Route{"/deploy": {Workflow: "deploy", WorkflowID: "deploy-{channel}"}},
+	})
+	m := newSocketMode(s)
+	m.connectionURL = func(ctx context.Context) (string, error) {
+		return connectionsOpen(ctx, client.New(), srv.URL+"/api/apps.connections.open", "xapp-token")
+	}
+
+	stop := m.start()
+	defer stop()
+
+	wantIDs := []string{"slack-event-E1", "actions-U1", "deploy-C1", "deploy-C1"}
+	for i, wantID := range wantIDs {
+		select {
+		case got := <-acks:
+			if want := strconv.Itoa(i + 1); got != want {
+				t.Errorf("ack %d = %q, want %q", i, got, want)
+			}
+			if got := c.lastDispatch().workflowID; got != wantID {
+				t.Errorf("envelope %d dispatched to workflow ID %q, want %q", i, got, wantID)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout waiting for ack %d", i)
+		}
+	}
+
+	select {
+	case <-reconnected:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for reconnection after disconnect request")
+	}
+}
+
+func TestConnectionsOpenError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"ok": false, "error": "invalid_auth"})
+	}))
+	defer srv.Close()
+
+	_, err := connectionsOpen(t.Context(), client.New(), srv.URL, "xapp-token")
+	if err == nil || !strings.Contains(err.Error(), "invalid_auth") {
+		t.Errorf("connectionsOpen() error = %v, want invalid_auth", err)
+	}
+}
+
+func TestNewDialer(t *testing.T) {
+	var connects atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodConnect && r.Host == "slack.invalid:443" {
+			connects.Add(1)
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &tls.Config{MinVersion: tls.VersionTLS13}
+	d := newDialer(client.New(client.WithProxy(proxyURL), client.WithTLSConfig(cfg), client.WithTimeout(7*time.Second)))
+
+	if d.TLSClientConfig != cfg {
+		t.Errorf("newDialer().TLSClientConfig = %v, want %v", d.TLSClientConfig, cfg)
+	}
+	if d.HandshakeTimeout != 7*time.Second {
+		t.Errorf("newDialer().HandshakeTimeout = %v, want %v", d.HandshakeTimeout, 7*time.Second)
+	}
+
+	if _, _, err := d.DialContext(t.Context(), "wss://slack.invalid/link", nil); err == nil {
+		t.Error("DialContext() error = nil, want proxy error")
+	}
+	if connects.Load() != 1 {
+		t.Errorf("proxy received %d CONNECT requests, want 1", connects.Load())
+	}
+}
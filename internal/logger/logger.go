@@ -0,0 +1,49 @@
+// Package logger provides a Temporal logger for code which runs both
+// inside Temporal activities and outside of them (e.g. HTTP handlers).
+package logger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	zlog "github.com/rs/zerolog/log"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/log"
+)
+
+// FromContext returns the Temporal activity logger if the context belongs to an
+// activity, or an adapter of the global zerolog logger if it doesn't.
+func FromContext(ctx context.Context) log.Logger {
+	if activity.IsActivity(ctx) {
+		return activity.GetLogger(ctx)
+	}
+	return zerologAdapter{}
+}
+
+// zerologAdapter implements the https://pkg.go.dev/go.temporal.io/sdk/log#Logger
+// interface using the global zerolog logger, outside of Temporal activities.
+type zerologAdapter struct{}
+
+func (zerologAdapter) Debug(msg string, keyvals ...any) {
+	logWithKeyvals(zlog.Debug(), msg, keyvals)
+}
+
+func (zerologAdapter) Info(msg string, keyvals ...any) {
+	logWithKeyvals(zlog.Info(), msg, keyvals)
+}
+
+func (zerologAdapter) Warn(msg string, keyvals ...any) {
+	logWithKeyvals(zlog.Warn(), msg, keyvals)
+}
+
+func (zerologAdapter) Error(msg string, keyvals ...any) {
+	logWithKeyvals(zlog.Error(), msg, keyvals)
+}
+
+func logWithKeyvals(e *zerolog.Event, msg string, keyvals []any) {
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		e = e.Any(fmt.Sprint(keyvals[i]), keyvals[i+1])
+	}
+	e.Msg(msg)
+}
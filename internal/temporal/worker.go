@@ -11,11 +11,15 @@ import (
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/worker"
 
+	"github.com/tzrikka/ovid/internal/inbound"
+	httpclient "github.com/tzrikka/ovid/pkg/client"
 	"github.com/tzrikka/ovid/pkg/slack"
 )
 
-// Start initializes application logging and the Temporal worker.
-func Start(_ context.Context, cmd *cli.Command) error {
+// Start initializes application logging, the Temporal worker,
+// and the optional receiver of inbound Slack requests. It validates
+// the configured Slack link before the worker starts polling.
+func Start(ctx context.Context, cmd *cli.Command) error {
 	logger := initLog(cmd.Bool("dev"))
 
 	c, err := client.Dial(client.Options{
@@ -28,9 +32,24 @@ func Start(_ context.Context, cmd *cli.Command) error {
 	}
 	defer c.Close()
 
+	hc, err := httpclient.NewFromFlags(cmd)
+	if err != nil {
+		return fmt.Errorf("HTTP client error: %w", err)
+	}
+
+	if err := slack.CheckLink(ctx, cmd, hc); err != nil {
+		return fmt.Errorf("Slack link check error: %w", err)
+	}
+
+	stop, err := inbound.Start(cmd, c, hc)
+	if err != nil {
+		return fmt.Errorf("inbound Slack receiver error: %w", err)
+	}
+	defer stop()
+
 	w := worker.New(c, cmd.String("temporal-task-queue"), worker.Options{})
 
-	slack.Register(cmd, w)
+	slack.Register(cmd, w, hc)
 
 	return w.Run(worker.InterruptCh())
 }
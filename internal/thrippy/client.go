@@ -11,7 +11,6 @@ import (
 
 	"github.com/lithammer/shortuuid/v4"
 	"github.com/urfave/cli/v3"
-	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/log"
 	"go.temporal.io/sdk/temporal"
 	"google.golang.org/grpc"
@@ -19,6 +18,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	thrippypb "github.com/tzrikka/thrippy-api/thrippy/v1"
+
+	"github.com/tzrikka/ovid/internal/logger"
 )
 
 const (
@@ -64,7 +65,7 @@ func (t *LinkClient) connection(l log.Logger, providerName string) (*grpc.Client
 
 // LinkCreds returns the saved secrets corresponding to the receiver's Thrippy link ID.
 func (t *LinkClient) LinkCreds(ctx context.Context, providerName string) (map[string]string, error) {
-	l := activity.GetLogger(ctx)
+	l := logger.FromContext(ctx)
 
 	conn, err := t.connection(l, providerName)
 	if err != nil {
@@ -89,7 +90,7 @@ func (t *LinkClient) LinkCreds(ctx context.Context, providerName string) (map[st
 
 // LinkData returns the template name and saved secrets corresponding to the receiver's Thrippy link ID.
 func (t *LinkClient) LinkData(ctx context.Context, providerName string) (string, map[string]string, error) {
-	l := activity.GetLogger(ctx)
+	l := logger.FromContext(ctx)
 
 	conn, err := t.connection(l, providerName)
 	if err != nil {
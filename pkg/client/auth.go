@@ -0,0 +1,237 @@
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Authenticator adds credentials to HTTP requests, right before they
+// are sent. Use it with [Client.AuthenticatedRequest] for APIs which
+// don't support bearer tokens (the default in [Client.Request]), and
+// [LinkAuthenticator] to choose one based on a Thrippy link.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// BearerToken is an [Authenticator] which sets the "Authorization" header
+// to "Bearer <token>" (https://www.rfc-editor.org/rfc/rfc6750). If the
+// token is empty, the request is sent without an "Authorization" header.
+type BearerToken string
+
+func (t BearerToken) Authenticate(req *http.Request) error {
+	if t != "" {
+		req.Header.Set("Authorization", "Bearer "+string(t))
+	}
+	return nil
+}
+
+// BasicAuth is an [Authenticator] which sets the "Authorization"
+// header to "Basic <base64(username:password)>" (https://www.rfc-editor.org/rfc/rfc7617).
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+func (a BasicAuth) Authenticate(req *http.Request) error {
+	req.SetBasicAuth(a.Username, a.Password)
+	return nil
+}
+
+// HeaderAuth is an [Authenticator] which sets a custom header,
+// e.g. "X-API-Key", to a static value (e.g. an API key).
+type HeaderAuth struct {
+	Name  string
+	Value string
+}
+
+func (a HeaderAuth) Authenticate(req *http.Request) error {
+	req.Header.Set(a.Name, a.Value)
+	return nil
+}
+
+// DefaultSignatureHeader is the default header in [HMACSignature].
+const DefaultSignatureHeader = "X-Signature"
+
+// HMACSignature is an [Authenticator] which signs the request body with a shared
+// secret, and sets a header to the hex-encoded signature, after an optional prefix
+// (e.g. "sha256="). If TimestampHeader is set, the request also specifies the
+// current Unix time in it, and the signed payload is "<timestamp>:<body>",
+// to prevent replay attacks. The hash function defaults to SHA-256.
+type HMACSignature struct {
+	Secret []byte
+	Hash   func() hash.Hash
+
+	Header          string // Default: [DefaultSignatureHeader].
+	Prefix          string
+	TimestampHeader string
+
+	now func() time.Time // For unit tests.
+}
+
+func (s HMACSignature) Authenticate(req *http.Request) error {
+	body, err := requestBodyBytes(req)
+	if err != nil {
+		return err
+	}
+
+	hashFunc := s.Hash
+	if hashFunc == nil {
+		hashFunc = sha256.New
+	}
+	mac := hmac.New(hashFunc, s.Secret)
+
+	if s.TimestampHeader != "" {
+		now := time.Now
+		if s.now != nil {
+			now = s.now
+		}
+		ts := strconv.FormatInt(now().Unix(), 10)
+		req.Header.Set(s.TimestampHeader, ts)
+		mac.Write([]byte(ts + ":"))
+	}
+	mac.Write(body)
+
+	header := s.Header
+	if header == "" {
+		header = DefaultSignatureHeader
+	}
+	req.Header.Set(header, s.Prefix+hex.EncodeToString(mac.Sum(nil)))
+	return nil
+}
+
+// requestBodyBytes returns the body of a request without consuming it.
+func requestBodyBytes(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody == nil {
+		return nil, errors.New("HTTP request body can't be read more than once")
+	}
+
+	r, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
+
+// LinkAuthFunc selects an [Authenticator] for API requests, based on the saved
+// credentials of a Thrippy link. The kind parameter optionally selects one of
+// multiple credentials, for providers which have them (e.g. Slack bot and
+// user tokens). Missing credentials result in non-retryable errors.
+type LinkAuthFunc func(creds map[string]string, kind string) (Authenticator, error)
+
+var (
+	linkAuthMu    sync.RWMutex
+	linkAuthFuncs = map[string]LinkAuthFunc{
+		"generic-api-key":      apiKeyAuth,
+		"generic-basic-auth":   basicAuth,
+		"generic-bearer-token": bearerAuth,
+		"generic-hmac-secret":  hmacAuth,
+	}
+)
+
+// RegisterLinkAuthenticator registers the [LinkAuthFunc] of a Thrippy link template
+// (e.g. "slack-oauth"), or of all the templates of a provider (e.g. "slack").
+// Link-specific packages call it in their init functions.
+func RegisterLinkAuthenticator(templateOrProvider string, f LinkAuthFunc) {
+	linkAuthMu.Lock()
+	defer linkAuthMu.Unlock()
+
+	linkAuthFuncs[templateOrProvider] = f
+}
+
+// LinkAuthenticator returns an [Authenticator] for API requests with a Thrippy
+// link, based on its template name and saved credentials. It uses the [LinkAuthFunc]
+// of the template, or else of the template's provider (the prefix before the first
+// "-"), which link-specific packages register with [RegisterLinkAuthenticator].
+// Built-in templates:
+//
+//   - "generic-api-key": [HeaderAuth] with "api_key", and the
+//     optional "api_key_header" (default: "X-API-Key")
+//   - "generic-basic-auth": [BasicAuth] with "username" and "password"
+//   - "generic-bearer-token": [BearerToken] with "token"
+//   - "generic-hmac-secret": [HMACSignature] with "hmac_secret", and the
+//     optional "signature_header", "signature_prefix" and "timestamp_header"
+//
+// It returns a non-retryable error if the template is unknown.
+func LinkAuthenticator(template string, creds map[string]string, kind string) (Authenticator, error) {
+	linkAuthMu.RLock()
+	f, ok := linkAuthFuncs[template]
+	if !ok {
+		provider, _, _ := strings.Cut(template, "-")
+		f, ok = linkAuthFuncs[provider]
+	}
+	linkAuthMu.RUnlock()
+
+	if !ok {
+		return nil, requestError(fmt.Sprintf("unsupported Thrippy link template %q", template))
+	}
+	return f(creds, kind)
+}
+
+func apiKeyAuth(creds map[string]string, _ string) (Authenticator, error) {
+	key, err := credential(creds, "api_key")
+	if err != nil {
+		return nil, err
+	}
+
+	name := creds["api_key_header"]
+	if name == "" {
+		name = "X-API-Key"
+	}
+	return HeaderAuth{Name: name, Value: key}, nil
+}
+
+func basicAuth(creds map[string]string, _ string) (Authenticator, error) {
+	user, err := credential(creds, "username")
+	if err != nil {
+		return nil, err
+	}
+	pass, err := credential(creds, "password")
+	if err != nil {
+		return nil, err
+	}
+	return BasicAuth{Username: user, Password: pass}, nil
+}
+
+func bearerAuth(creds map[string]string, _ string) (Authenticator, error) {
+	t, err := credential(creds, "token")
+	if err != nil {
+		return nil, err
+	}
+	return BearerToken(t), nil
+}
+
+func hmacAuth(creds map[string]string, _ string) (Authenticator, error) {
+	secret, err := credential(creds, "hmac_secret")
+	if err != nil {
+		return nil, err
+	}
+	return HMACSignature{
+		Secret:          []byte(secret),
+		Header:          creds["signature_header"],
+		Prefix:          creds["signature_prefix"],
+		TimestampHeader: creds["timestamp_header"],
+	}, nil
+}
+
+// credential returns a required credential of a Thrippy link.
+func credential(creds map[string]string, key string) (string, error) {
+	if v := creds[key]; v != "" {
+		return v, nil
+	}
+	return "", requestError(fmt.Sprintf("%q not found in Thrippy link credentials", key))
+}
@@ -0,0 +1,239 @@
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+func TestAuthenticators(t *testing.T) {
+	tests := []struct {
+		name       string
+		auth       Authenticator
+		wantHeader string
+		want       string
+	}{
+		{
+			name:       "bearer_token",
+			auth:       BearerToken("token"),
+			wantHeader: "Authorization",
+			want:       "Bearer token",
+		},
+		{
+			name:       "empty_bearer_token",
+			auth:       BearerToken(""),
+			wantHeader: "Authorization",
+		},
+		{
+			name:       "basic_auth",
+			auth:       BasicAuth{Username: "user", Password: "pass"},
+			wantHeader: "Authorization",
+			want:       "Basic dXNlcjpwYXNz",
+		},
+		{
+			name:       "header_auth",
+			auth:       HeaderAuth{Name: "X-API-Key", Value: "key"},
+			wantHeader: "X-API-Key",
+			want:       "key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com", http.NoBody)
+			if err := tt.auth.Authenticate(req); err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			if got := req.Header.Get(tt.wantHeader); got != tt.want {
+				t.Errorf("Authenticate() %s header = %q, want %q", tt.wantHeader, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHMACSignature(t *testing.T) {
+	body := `{"a":1}`
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := HMACSignature{
+		Secret:          []byte("secret"),
+		Prefix:          "v0=",
+		TimestampHeader: "X-Timestamp",
+		now:             func() time.Time { return time.Unix(1700000000, 0) },
+	}
+	if err := s.Authenticate(req); err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1700000000:" + body))
+	want := "v0=" + hex.EncodeToString(mac.Sum(nil))
+
+	if got := req.Header.Get(DefaultSignatureHeader); got != want {
+		t.Errorf("Authenticate() signature = %q, want %q", got, want)
+	}
+	if got := req.Header.Get("X-Timestamp"); got != "1700000000" {
+		t.Errorf("Authenticate() timestamp = %q, want %q", got, "1700000000")
+	}
+
+	// The request body must still be readable after signing.
+	b, err := requestBodyBytes(req)
+	if err != nil || string(b) != body {
+		t.Errorf("requestBodyBytes() = %q, %v, want %q", string(b), err, body)
+	}
+}
+
+func TestLinkAuthenticator(t *testing.T) {
+	RegisterLinkAuthenticator("test", func(creds map[string]string, kind string) (Authenticator, error) {
+		return BearerToken(creds[kind+"_token"]), nil
+	})
+	t.Cleanup(func() { delete(linkAuthFuncs, "test") })
+
+	tests := []struct {
+		name     string
+		template string
+		creds    map[string]string
+		kind     string
+		want     Authenticator
+		wantErr  bool
+	}{
+		{
+			name:     "api_key_default_header",
+			template: "generic-api-key",
+			creds:    map[string]string{"api_key": "key"},
+			want:     HeaderAuth{Name: "X-API-Key", Value: "key"},
+		},
+		{
+			name:     "api_key_custom_header",
+			template: "generic-api-key",
+			creds:    map[string]string{"api_key": "key", "api_key_header": "X-Auth"},
+			want:     HeaderAuth{Name: "X-Auth", Value: "key"},
+		},
+		{
+			name:     "basic_auth",
+			template: "generic-basic-auth",
+			creds:    map[string]string{"username": "user", "password": "pass"},
+			want:     BasicAuth{Username: "user", Password: "pass"},
+		},
+		{
+			name:     "bearer_token",
+			template: "generic-bearer-token",
+			creds:    map[string]string{"token": "token"},
+			want:     BearerToken("token"),
+		},
+		{
+			name:     "hmac_secret",
+			template: "generic-hmac-secret",
+			creds:    map[string]string{"hmac_secret": "secret", "signature_header": "X-Sig"},
+			want:     HMACSignature{Secret: []byte("secret"), Header: "X-Sig"},
+		},
+		{
+			name:     "registered_provider",
+			template: "test-oauth",
+			creds:    map[string]string{"bot_token": "b", "user_token": "u"},
+			kind:     "user",
+			want:     BearerToken("u"),
+		},
+		{
+			name:     "unknown_template",
+			template: "unknown-oauth",
+			creds:    map[string]string{"token": "token"},
+			wantErr:  true,
+		},
+		{
+			name:     "missing_api_key",
+			template: "generic-api-key",
+			creds:    map[string]string{"api_key_header": "X-Auth"},
+			wantErr:  true,
+		},
+		{
+			name:     "missing_password",
+			template: "generic-basic-auth",
+			creds:    map[string]string{"username": "user"},
+			wantErr:  true,
+		},
+		{
+			name:     "missing_token",
+			template: "generic-bearer-token",
+			wantErr:  true,
+		},
+		{
+			name:     "missing_hmac_secret",
+			template: "generic-hmac-secret",
+			creds:    map[string]string{"signature_header": "X-Sig"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LinkAuthenticator(tt.template, tt.creds, tt.kind)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LinkAuthenticator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				var appErr *temporal.ApplicationError
+				if !errors.As(err, &appErr) || !appErr.NonRetryable() {
+					t.Errorf("LinkAuthenticator() error = %v, want non-retryable error", err)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LinkAuthenticator() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthenticatedRequest(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-API-Key"); got != "key" {
+			t.Errorf("X-API-Key header = %q, want %q", got, "key")
+		}
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("Authorization header = %q, want none", got)
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer s.Close()
+
+	got, err := New().AuthenticatedRequest(t.Context(), http.MethodGet, s.URL, HeaderAuth{Name: "X-API-Key", Value: "key"}, nil)
+	if err != nil {
+		t.Fatalf("AuthenticatedRequest() error = %v", err)
+	}
+	if string(got) != "ok" {
+		t.Errorf("AuthenticatedRequest() = %q, want %q", string(got), "ok")
+	}
+}
+
+func TestScrubHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Bearer token")
+	h.Set("X-API-Key", "key")
+	h.Set("X-Signature", "sig")
+	h.Set("Content-Type", "application/json")
+
+	got := scrubHeaders(h)
+	for _, k := range []string{"Authorization", "X-API-Key", "X-Signature"} {
+		if v := got.Get(k); v != redacted {
+			t.Errorf("scrubHeaders() %s = %q, want %q", k, v, redacted)
+		}
+	}
+	if v := got.Get("Content-Type"); v != "application/json" {
+		t.Errorf("scrubHeaders() Content-Type = %q, want %q", v, "application/json")
+	}
+	if h.Get("X-API-Key") != "key" {
+		t.Error("scrubHeaders() modified its input")
+	}
+}
@@ -0,0 +1,375 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// RecordEnvVar is the environment variable which switches [ModeFromEnv]
+// to [ModeRecord], e.g. to refresh cassettes against real APIs:
+//
+//	OVID_RECORD_CASSETTES=1 go test ./...
+const RecordEnvVar = "OVID_RECORD_CASSETTES"
+
+// redacted replaces the values of sensitive headers in cassettes.
+const redacted = "REDACTED"
+
+// sensitiveHeaders are scrubbed from requests and responses before they are saved.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Set-Cookie",
+}
+
+// sensitiveHeaderWords are also scrubbed if they appear in any header name, to
+// cover custom authentication headers (see [HeaderAuth] and [HMACSignature]).
+// The same goes for the names of query parameters, form fields and JSON object
+// keys in recorded URLs and bodies (e.g. "client_secret" or "access_token").
+var sensitiveHeaderWords = []string{"key", "secret", "signature", "token"}
+
+// Mode determines whether a [Recorder] sends real HTTP requests.
+type Mode int
+
+const (
+	// ModeReplay serves responses only from a cassette
+	// file, without sending any HTTP requests.
+	ModeReplay Mode = iota
+	// ModeRecord sends real HTTP requests, and saves them
+	// with their responses when [Recorder.Save] is called.
+	ModeRecord
+)
+
+// ModeFromEnv returns [ModeRecord] if the environment
+// variable [RecordEnvVar] is set to true, or else [ModeReplay].
+func ModeFromEnv() Mode {
+	if b, _ := strconv.ParseBool(os.Getenv(RecordEnvVar)); b {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// Cassette is a sequence of recorded HTTP interactions, stored as a JSON file.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded HTTP request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an [http.RoundTripper] which records HTTP interactions in a
+// [Cassette] file, and replays them, so tests can run deterministically with no
+// network access. Use it as the transport of a [Client] with [WithTransport].
+//
+// In replay mode, each request is matched to the first unused interaction with
+// the same method, URL and body (multipart bodies are not compared, because their
+// boundaries are random). Unmatched requests fail without reaching the network.
+//
+// Recorded URLs, bodies and headers are sanitized: the values of sensitive query
+// parameters, form fields, JSON object keys and headers are replaced with
+// "REDACTED". Replayed requests are sanitized the same way before matching.
+type Recorder struct {
+	path string
+	mode Mode
+	base http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// NewRecorder initializes a [Recorder] for the given cassette file. In [ModeReplay]
+// the file must already exist. In [ModeRecord] it is overwritten when [Recorder.Save]
+// is called, and requests are sent with the base transport, or with
+// [http.DefaultTransport] if it is nil.
+func NewRecorder(path string, mode Mode, base http.RoundTripper) (*Recorder, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	r := &Recorder{path: path, mode: mode, base: base}
+	if mode == ModeRecord {
+		return r, nil
+	}
+
+	b, err := os.ReadFile(path) //gosec:disable G304 -- test fixture file by design
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HTTP cassette: %w", err)
+	}
+	if err := json.Unmarshal(b, &r.cassette); err != nil {
+		return nil, fmt.Errorf("failed to decode HTTP cassette %q: %w", path, err)
+	}
+
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// RoundTrip implements the [http.RoundTripper] interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+	return r.replay(req, body)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	out.Body = io.NopCloser(bytes.NewReader(body))
+
+	resp, err := r.base.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recorded HTTP response body: %w", err)
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    scrubURL(req.URL),
+			Header: scrubHeaders(req.Header),
+			Body:   scrubBody(body, req.Header.Get("Content-Type")),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     scrubHeaders(resp.Header),
+			Body:       scrubBody(respBody, resp.Header.Get("Content-Type")),
+		},
+	})
+	r.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || !matches(in.Request, req, body) {
+			continue
+		}
+		r.used[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewBufferString(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no unused interaction in HTTP cassette %q for %s %s", r.path, req.Method, req.URL)
+}
+
+// Save writes the recorded interactions to the cassette file,
+// if the receiver is in [ModeRecord]. Otherwise, it does nothing.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode HTTP cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o750); err != nil {
+		return fmt.Errorf("failed to create HTTP cassette directory: %w", err)
+	}
+	if err := os.WriteFile(r.path, append(b, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write HTTP cassette: %w", err)
+	}
+
+	return nil
+}
+
+// readRequestBody reads the body of a request without consuming it.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read HTTP request body: %w", err)
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HTTP request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+func matches(rec RecordedRequest, req *http.Request, body []byte) bool {
+	if rec.Method != req.Method || rec.URL != scrubURL(req.URL) {
+		return false
+	}
+
+	contentType := req.Header.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "multipart/form-data" {
+		return true
+	}
+
+	return rec.Body == scrubBody(body, contentType)
+}
+
+// scrubHeaders returns a copy of the given headers, without sensitive values.
+func scrubHeaders(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+
+	h = h.Clone()
+	for k := range h {
+		if isSensitiveHeader(k) {
+			h.Set(k, redacted)
+		}
+	}
+	return h
+}
+
+func isSensitiveHeader(name string) bool {
+	return slices.Contains(sensitiveHeaders, http.CanonicalHeaderKey(name)) || isSensitiveKey(name)
+}
+
+func isSensitiveKey(name string) bool {
+	name = strings.ToLower(name)
+	return slices.ContainsFunc(sensitiveHeaderWords, func(w string) bool {
+		return strings.Contains(name, w)
+	})
+}
+
+// scrubURL returns the given URL, without the values of sensitive query
+// parameters. URLs without any are returned as-is, to preserve their encoding.
+func scrubURL(u *url.URL) string {
+	q, changed := scrubValues(u.Query())
+	if !changed {
+		return u.String()
+	}
+
+	u2 := *u
+	u2.RawQuery = q.Encode()
+	return u2.String()
+}
+
+// scrubBody returns the given HTTP request or response body, without the values
+// of sensitive form fields or JSON object keys. Other bodies, and bodies
+// without sensitive values, are returned as-is, to preserve their encoding.
+func scrubBody(body []byte, contentType string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		vs, err := url.ParseQuery(string(body))
+		if err != nil {
+			return string(body)
+		}
+		if vs, changed := scrubValues(vs); changed {
+			return vs.Encode()
+		}
+
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		d := json.NewDecoder(bytes.NewReader(body))
+		d.UseNumber()
+		var v any
+		if err := d.Decode(&v); err != nil {
+			return string(body)
+		}
+		if scrubJSON(v) {
+			if b, err := json.Marshal(v); err == nil {
+				return string(b)
+			}
+		}
+	}
+
+	return string(body)
+}
+
+// scrubValues redacts sensitive query parameters or form fields in-place,
+// and reports whether there were any.
+func scrubValues(vs url.Values) (url.Values, bool) {
+	changed := false
+	for k := range vs {
+		if isSensitiveKey(k) {
+			vs[k] = []string{redacted}
+			changed = true
+		}
+	}
+	return vs, changed
+}
+
+// scrubJSON redacts the values of sensitive keys in a decoded
+// JSON value in-place, and reports whether there were any.
+func scrubJSON(v any) bool {
+	changed := false
+	switch v := v.(type) {
+	case map[string]any:
+		for k, vv := range v {
+			if isSensitiveKey(k) {
+				v[k] = redacted
+				changed = true
+			} else if scrubJSON(vv) {
+				changed = true
+			}
+		}
+	case []any:
+		for _, vv := range v {
+			if scrubJSON(vv) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Set-Cookie", "session=secret")
+		_, _ = w.Write([]byte(r.Method + " " + r.URL.RawQuery))
+	}))
+	path := filepath.Join(t.TempDir(), "cassettes", "test.json")
+
+	// Record.
+	rec, err := NewRecorder(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder(ModeRecord) error = %v", err)
+	}
+	c := New(WithTransport(rec))
+
+	query := url.Values{"k": []string{"v"}}
+	if _, err := c.Request(t.Context(), http.MethodGet, s.URL, "secret-token", query); err != nil {
+		t.Fatalf("Client.Request() error = %v", err)
+	}
+	if _, err := c.Request(t.Context(), http.MethodPost, s.URL, "secret-token", map[string]string{"k": "v"}); err != nil {
+		t.Fatalf("Client.Request() error = %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Recorder.Save() error = %v", err)
+	}
+	s.Close()
+
+	b, err := os.ReadFile(path) //gosec:disable G304 -- test file
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "secret") {
+		t.Errorf("cassette contains sensitive data:\n%s", b)
+	}
+
+	// Replay.
+	rec, err = NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder(ModeReplay) error = %v", err)
+	}
+	c = New(WithTransport(rec))
+
+	got, err := c.Request(t.Context(), http.MethodPost, s.URL, "other-token", map[string]string{"k": "v"})
+	if err != nil {
+		t.Fatalf("Client.Request() error = %v", err)
+	}
+	if want := "POST "; string(got) != want {
+		t.Errorf("Client.Request() = %q, want %q", got, want)
+	}
+
+	got, err = c.Request(t.Context(), http.MethodGet, s.URL, "", query)
+	if err != nil {
+		t.Fatalf("Client.Request() error = %v", err)
+	}
+	if want := "GET k=v"; string(got) != want {
+		t.Errorf("Client.Request() = %q, want %q", got, want)
+	}
+
+	// Each interaction is replayed only once, and unmatched requests fail.
+	if _, err := c.Request(t.Context(), http.MethodGet, s.URL, "", query); err == nil {
+		t.Error("Client.Request() error = nil, want no unused interaction")
+	}
+	if _, err := c.Request(t.Context(), http.MethodPost, s.URL, "", map[string]string{"k": "x"}); err == nil {
+		t.Error("Client.Request() error = nil, want no matching interaction")
+	}
+}
+
+func TestRecorderScrubsURLsAndBodies(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"access_token":"secret-response","team":{"id":"T1"}}`))
+	}))
+	path := filepath.Join(t.TempDir(), "test.json")
+
+	requests := func(c *Client, secret string) []string {
+		t.Helper()
+		var got []string
+		for _, body := range []any{
+			FormBody{"client_id": {"id"}, "client_secret": {secret}},
+			map[string]any{"text": "hi", "auth": map[string]string{"api_key": secret}},
+		} {
+			resp, err := c.Request(t.Context(), http.MethodPost, s.URL+"?token="+secret+"&a=b", "", body)
+			if err != nil {
+				t.Fatalf("Client.Request() error = %v", err)
+			}
+			got = append(got, string(resp))
+		}
+		return got
+	}
+
+	// Record.
+	rec, err := NewRecorder(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder(ModeRecord) error = %v", err)
+	}
+	requests(New(WithTransport(rec)), "secret-request")
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Recorder.Save() error = %v", err)
+	}
+	s.Close()
+
+	b, err := os.ReadFile(path) //gosec:disable G304 -- test file
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "secret-") {
+		t.Errorf("cassette contains sensitive data:\n%s", b)
+	}
+
+	// Replay, with different secrets.
+	rec, err = NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder(ModeReplay) error = %v", err)
+	}
+	want := `{"access_token":"REDACTED","ok":true,"team":{"id":"T1"}}`
+	for _, got := range requests(New(WithTransport(rec)), "other-secret") {
+		if got != want {
+			t.Errorf("Client.Request() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestNewRecorderMissingCassette(t *testing.T) {
+	if _, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay, nil); err == nil {
+		t.Error("NewRecorder() error = nil, want missing file error")
+	}
+}
+
+func TestModeFromEnv(t *testing.T) {
+	t.Setenv(RecordEnvVar, "")
+	if got := ModeFromEnv(); got != ModeReplay {
+		t.Errorf("ModeFromEnv() = %v, want ModeReplay", got)
+	}
+
+	t.Setenv(RecordEnvVar, "true")
+	if got := ModeFromEnv(); got != ModeRecord {
+		t.Errorf("ModeFromEnv() = %v, want ModeRecord", got)
+	}
+}
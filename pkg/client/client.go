@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	DefaultTimeout         = 3 * time.Second
+	DefaultSlowTimeout     = 10 * time.Second
+	DefaultMaxResponseSize = 10 << 20 // 10 MiB.
+)
+
+// Client sends HTTP requests to external APIs. It's safe for concurrent use.
+// Use [New] to initialize it with non-default options, or [NewFromFlags] to
+// initialize it based on the application's CLI flags and configuration file.
+type Client struct {
+	httpClient      *http.Client
+	timeout         time.Duration
+	slowTimeout     time.Duration
+	maxResponseSize int64
+	userAgent       string
+}
+
+// Option configures a [Client] in [New].
+type Option func(*options)
+
+type options struct {
+	transport       http.RoundTripper
+	proxy           *url.URL
+	tlsConfig       *tls.Config
+	timeout         time.Duration
+	slowTimeout     time.Duration
+	maxResponseSize int64
+	userAgent       string
+}
+
+// WithTransport sets the base transport of the client, instead of
+// [http.DefaultTransport] (e.g. to tune connection pooling). If it isn't an
+// [*http.Transport], the [WithProxy] and [WithTLSConfig] options are ignored.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(o *options) {
+		o.transport = rt
+	}
+}
+
+// WithProxy sets the URL of an HTTP proxy for all requests, instead
+// of the environment variables "HTTP_PROXY", "HTTPS_PROXY" and "NO_PROXY".
+func WithProxy(u *url.URL) Option {
+	return func(o *options) {
+		o.proxy = u
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the client's
+// transport, e.g. to trust custom certificate authorities.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = cfg
+	}
+}
+
+// WithTimeout overrides [DefaultTimeout] for all requests, if it's positive.
+// Use [WithRequestTimeout] to override it for specific requests.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+// WithSlowTimeout overrides [DefaultSlowTimeout], if it's positive. This is
+// the timeout which link-specific packages apply (with [WithRequestTimeout])
+// to API methods that are known to be slow. See [Client.SlowTimeout].
+func WithSlowTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.slowTimeout = d
+	}
+}
+
+// WithMaxResponseSize overrides [DefaultMaxResponseSize], if it's
+// positive. Larger response bodies result in non-retryable errors.
+func WithMaxResponseSize(n int64) Option {
+	return func(o *options) {
+		o.maxResponseSize = n
+	}
+}
+
+// WithUserAgent sets the "User-Agent" header of all requests,
+// instead of the default one of the [net/http] package.
+func WithUserAgent(ua string) Option {
+	return func(o *options) {
+		o.userAgent = ua
+	}
+}
+
+// New initializes a [Client] with the given options.
+func New(opts ...Option) *Client {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.timeout <= 0 {
+		o.timeout = DefaultTimeout
+	}
+	if o.slowTimeout <= 0 {
+		o.slowTimeout = DefaultSlowTimeout
+	}
+	if o.maxResponseSize <= 0 {
+		o.maxResponseSize = DefaultMaxResponseSize
+	}
+
+	rt := o.transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	if t, ok := rt.(*http.Transport); ok && (o.proxy != nil || o.tlsConfig != nil) {
+		t = t.Clone()
+		if o.proxy != nil {
+			t.Proxy = http.ProxyURL(o.proxy)
+		}
+		if o.tlsConfig != nil {
+			t.TLSClientConfig = o.tlsConfig
+		}
+		rt = t
+	}
+
+	return &Client{
+		httpClient:      &http.Client{Transport: rt},
+		timeout:         o.timeout,
+		slowTimeout:     o.slowTimeout,
+		maxResponseSize: o.maxResponseSize,
+		userAgent:       o.userAgent,
+	}
+}
+
+type timeoutKey struct{}
+
+// WithRequestTimeout returns a copy of the context which overrides the
+// client's timeout for requests that are sent with it (e.g. slow API methods).
+func WithRequestTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutKey{}, d)
+}
+
+// requestTimeout returns the timeout of a request: the one in
+// the context, if there is one, or else the client's default.
+func (c *Client) requestTimeout(ctx context.Context) time.Duration {
+	if d, ok := ctx.Value(timeoutKey{}).(time.Duration); ok && d > 0 {
+		return d
+	}
+	return c.timeout
+}
+
+// SlowTimeout returns the client's timeout for slow API methods
+// (see [WithSlowTimeout]), to use with [WithRequestTimeout].
+func (c *Client) SlowTimeout() time.Duration {
+	return c.slowTimeout
+}
+
+// Timeout returns the client's default timeout (see [WithTimeout]).
+func (c *Client) Timeout() time.Duration {
+	return c.timeout
+}
+
+// Transport returns the client's base transport, e.g. to reuse its proxy and TLS
+// settings for WebSocket connections, or nil if it isn't an [*http.Transport]
+// (see [WithTransport]). The returned transport must not be modified.
+func (c *Client) Transport() *http.Transport {
+	t, _ := c.httpClient.Transport.(*http.Transport)
+	return t
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+func TestNew(t *testing.T) {
+	c := New()
+	if c.timeout != DefaultTimeout {
+		t.Errorf("New().timeout = %v, want %v", c.timeout, DefaultTimeout)
+	}
+	if c.SlowTimeout() != DefaultSlowTimeout {
+		t.Errorf("New().SlowTimeout() = %v, want %v", c.SlowTimeout(), DefaultSlowTimeout)
+	}
+	if c.maxResponseSize != DefaultMaxResponseSize {
+		t.Errorf("New().maxResponseSize = %d, want %d", c.maxResponseSize, DefaultMaxResponseSize)
+	}
+	if c.httpClient.Transport != http.DefaultTransport {
+		t.Errorf("New().httpClient.Transport = %v, want http.DefaultTransport", c.httpClient.Transport)
+	}
+
+	c = New(WithTimeout(-1), WithSlowTimeout(-1), WithMaxResponseSize(0))
+	if c.timeout != DefaultTimeout {
+		t.Errorf("New(WithTimeout(-1)).timeout = %v, want %v", c.timeout, DefaultTimeout)
+	}
+	if c.SlowTimeout() != DefaultSlowTimeout {
+		t.Errorf("New(WithSlowTimeout(-1)).SlowTimeout() = %v, want %v", c.SlowTimeout(), DefaultSlowTimeout)
+	}
+	if c.maxResponseSize != DefaultMaxResponseSize {
+		t.Errorf("New(WithMaxResponseSize(0)).maxResponseSize = %d, want %d", c.maxResponseSize, DefaultMaxResponseSize)
+	}
+}
+
+func TestNewProxyAndTLSConfig(t *testing.T) {
+	base := &http.Transport{}
+	proxy := &url.URL{Scheme: "http", Host: "proxy:8080"}
+	cfg := &tls.Config{MinVersion: tls.VersionTLS13}
+
+	c := New(WithTransport(base), WithProxy(proxy), WithTLSConfig(cfg))
+	got, ok := c.httpClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("New().httpClient.Transport = %T, want *http.Transport", c.httpClient.Transport)
+	}
+	if got == base {
+		t.Error("New() modified the base transport instead of cloning it")
+	}
+	if c.Transport() != got {
+		t.Errorf("Client.Transport() = %v, want %v", c.Transport(), got)
+	}
+	if got.TLSClientConfig != cfg {
+		t.Errorf("New().httpClient.Transport.TLSClientConfig = %v, want %v", got.TLSClientConfig, cfg)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com", http.NoBody)
+	u, err := got.Proxy(req)
+	if err != nil {
+		t.Fatalf("Transport.Proxy() error = %v", err)
+	}
+	if u.String() != proxy.String() {
+		t.Errorf("Transport.Proxy() = %q, want %q", u, proxy)
+	}
+}
+
+func TestClientUserAgent(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.UserAgent()))
+	}))
+	defer s.Close()
+
+	got, err := New(WithUserAgent("ovid-test")).Request(t.Context(), http.MethodGet, s.URL, "", url.Values{})
+	if err != nil {
+		t.Fatalf("Client.Request() error = %v", err)
+	}
+	if string(got) != "ovid-test" {
+		t.Errorf("Client.Request() = %q, want %q", got, "ovid-test")
+	}
+}
+
+func TestClientMaxResponseSize(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat("a", 10)))
+	}))
+	defer s.Close()
+
+	if _, err := New(WithMaxResponseSize(10)).Request(t.Context(), http.MethodGet, s.URL, "", url.Values{}); err != nil {
+		t.Errorf("Client.Request() error = %v", err)
+	}
+
+	_, err := New(WithMaxResponseSize(9)).Request(t.Context(), http.MethodGet, s.URL, "", url.Values{})
+	appErr := new(temporal.ApplicationError)
+	if !errors.As(err, &appErr) {
+		t.Fatalf("Client.Request() error = %v, want *temporal.ApplicationError", err)
+	}
+	if !appErr.NonRetryable() {
+		t.Errorf("Client.Request() error is retryable")
+	}
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	c := New(WithTimeout(time.Minute), WithSlowTimeout(time.Hour))
+	if got := c.requestTimeout(t.Context()); got != time.Minute {
+		t.Errorf("requestTimeout() = %v, want %v", got, time.Minute)
+	}
+	if got := c.SlowTimeout(); got != time.Hour {
+		t.Errorf("SlowTimeout() = %v, want %v", got, time.Hour)
+	}
+
+	ctx := WithRequestTimeout(t.Context(), time.Hour)
+	if got := c.requestTimeout(ctx); got != time.Hour {
+		t.Errorf("requestTimeout() = %v, want %v", got, time.Hour)
+	}
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer s.Close()
+
+	ctx = WithRequestTimeout(t.Context(), 10*time.Millisecond)
+	if _, err := c.Request(ctx, http.MethodGet, s.URL, "", url.Values{}); err == nil {
+		t.Error("Client.Request() error = nil, want timeout")
+	}
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	altsrc "github.com/urfave/cli-altsrc/v3"
+	"github.com/urfave/cli-altsrc/v3/toml"
+	"github.com/urfave/cli/v3"
+)
+
+// Flags defines CLI flags to configure the HTTP client of outbound API requests.
+// These flags can also be set using environment variables and the application's
+// configuration file.
+func Flags(configFilePath altsrc.StringSourcer) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "http-client-proxy-url",
+			Usage: "HTTP proxy URL for outbound API requests (default: based on HTTPS_PROXY)",
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("HTTP_CLIENT_PROXY_URL"),
+				toml.TOML("http_client.proxy_url", configFilePath),
+			),
+		},
+		&cli.StringFlag{
+			Name:  "http-client-ca-cert",
+			Usage: "additional CA certificate PEM file for outbound API requests",
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("HTTP_CLIENT_CA_CERT"),
+				toml.TOML("http_client.ca_cert", configFilePath),
+			),
+			TakesFile: true,
+		},
+		&cli.DurationFlag{
+			Name:  "http-client-timeout",
+			Usage: "default timeout of outbound API requests",
+			Value: DefaultTimeout,
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("HTTP_CLIENT_TIMEOUT"),
+				toml.TOML("http_client.timeout", configFilePath),
+			),
+		},
+		&cli.DurationFlag{
+			Name:  "http-client-slow-timeout",
+			Usage: "timeout of outbound API requests to slow methods (e.g. Slack's conversations.history)",
+			Value: DefaultSlowTimeout,
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("HTTP_CLIENT_SLOW_TIMEOUT"),
+				toml.TOML("http_client.slow_timeout", configFilePath),
+			),
+		},
+		&cli.IntFlag{
+			Name:  "http-client-max-response-size",
+			Usage: "maximum size in bytes of outbound API responses",
+			Value: DefaultMaxResponseSize,
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("HTTP_CLIENT_MAX_RESPONSE_SIZE"),
+				toml.TOML("http_client.max_response_size", configFilePath),
+			),
+		},
+		&cli.IntFlag{
+			Name:  "http-client-max-idle-conns-per-host",
+			Usage: "maximum idle (keep-alive) connections per host of outbound API requests",
+			Value: 2, // Same as http.DefaultMaxIdleConnsPerHost.
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("HTTP_CLIENT_MAX_IDLE_CONNS_PER_HOST"),
+				toml.TOML("http_client.max_idle_conns_per_host", configFilePath),
+			),
+		},
+		&cli.StringFlag{
+			Name:  "http-client-user-agent",
+			Usage: "User-Agent header of outbound API requests",
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("HTTP_CLIENT_USER_AGENT"),
+				toml.TOML("http_client.user_agent", configFilePath),
+			),
+		},
+	}
+}
+
+// NewFromFlags initializes a [Client] based on the CLI flags defined in [Flags].
+func NewFromFlags(cmd *cli.Command) (*Client, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.MaxIdleConnsPerHost = cmd.Int("http-client-max-idle-conns-per-host")
+
+	opts := []Option{
+		WithTransport(t),
+		WithTimeout(cmd.Duration("http-client-timeout")),
+		WithSlowTimeout(cmd.Duration("http-client-slow-timeout")),
+		WithMaxResponseSize(int64(cmd.Int("http-client-max-response-size"))),
+		WithUserAgent(cmd.String("http-client-user-agent")),
+	}
+
+	if p := cmd.String("http-client-proxy-url"); p != "" {
+		u, err := url.Parse(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid HTTP client proxy URL: %w", err)
+		}
+		opts = append(opts, WithProxy(u))
+	}
+
+	if path := cmd.String("http-client-ca-cert"); path != "" {
+		cfg, err := tlsConfig(path)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithTLSConfig(cfg))
+	}
+
+	return New(opts...), nil
+}
+
+// tlsConfig returns a TLS configuration which trusts the system's
+// certificate authorities, and also the one in the given PEM file.
+func tlsConfig(caCertPath string) (*tls.Config, error) {
+	pem, err := os.ReadFile(caCertPath) //gosec:disable G304 -- user-specified file by design
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HTTP client CA certificate: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.New("failed to parse HTTP client CA certificate")
+	}
+
+	return &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}, nil
+}
@@ -1,74 +1,199 @@
 // Package client provides a simple, generic HTTP client
-// for sending GET and POST requests to external APIs,
-// which is used by other link-specific Ovid packages.
+// for sending requests to external APIs, which is used
+// by other link-specific Ovid packages. Request bodies
+// are encoded as JSON by default, but may also be
+// [FormBody], [MultipartBody], or [RawBody].
+//
+// [Client] instances are configurable; [HTTPRequest]
+// uses a default one.
 package client
 
 import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.temporal.io/sdk/temporal"
 )
 
-const (
-	timeout = 3 * time.Second
-	maxSize = 10 << 20 // 10 MiB.
-)
+// JSONBody is an HTTP request body which is encoded as JSON. This is also the
+// default for any other body type, so it's needed only to encode [url.Values]
+// or one of the other body types in this package as JSON explicitly.
+type JSONBody struct {
+	Value any
+}
+
+// FormBody is an HTTP request body which is encoded as "application/x-www-form-urlencoded".
+type FormBody url.Values
+
+// RawBody is an HTTP request body which is sent as-is, instead of being encoded as JSON.
+// If ContentType is empty, it defaults to "application/octet-stream".
+type RawBody struct {
+	ContentType string
+	Data        []byte
+}
+
+// MultipartBody is an HTTP request body which is encoded as "multipart/form-data".
+type MultipartBody struct {
+	Fields map[string]string
+	Files  []MultipartFile
+}
+
+// MultipartFile is a file in a [MultipartBody]. If ContentType
+// is empty, it defaults to "application/octet-stream".
+type MultipartFile struct {
+	FieldName   string
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+// HTTPError is the cause of the [temporal.ApplicationError] that [HTTPRequest]
+// returns when an external API responds with an HTTP error status code.
+// It is also attached to that error as its details, so workflows can
+// inspect it too.
+//
+// RetryAfter is the parsed value of the response's "Retry-After" header,
+// if there is one (e.g. in HTTP 429 and 503 responses). In that case the
+// returned [temporal.ApplicationError] also specifies it as the delay
+// before the next activity retry attempt, overriding the retry policy.
+//
+// [temporal.ApplicationError]: https://pkg.go.dev/go.temporal.io/temporal#ApplicationError
+type HTTPError struct {
+	StatusCode int           `json:"status_code"`
+	Status     string        `json:"status"`
+	Body       string        `json:"body,omitempty"`
+	RetryAfter time.Duration `json:"retry_after,omitempty"`
+}
+
+func (e *HTTPError) Error() string {
+	if e.Body == "" {
+		return e.Status
+	}
+	return fmt.Sprintf("%s: %s", e.Status, e.Body)
+}
+
+const defaultContentType = "application/octet-stream"
+
+var defaultClient = New()
+
+// HTTPRequest sends an HTTP request to an external API,
+// using a [Client] with default options. See [Client.Request].
+func HTTPRequest(ctx context.Context, httpMethod, u, authToken string, queryOrBody any) ([]byte, error) {
+	return defaultClient.Request(ctx, httpMethod, u, authToken, queryOrBody)
+}
+
+// Request sends an HTTP GET, POST, PUT, PATCH or DELETE request to an external API.
+// For GET requests, the queryOrBody parameter is expected to be [url.Values] or nil.
+// For POST, PUT and PATCH requests, it should be a [JSONBody], a [FormBody], a
+// [MultipartBody], a [RawBody], nil (no body), or any other value that can be
+// encoded as JSON, except [url.Values]. DELETE requests accept both query parameters
+// and bodies. The request is authenticated with authToken as a [BearerToken]; if it's
+// empty, the request is sent without an "Authorization" header (see also
+// [Client.AuthenticatedRequest] for other schemes). Some errors (unsupported methods,
+// mismatched queries or bodies, failure to construct a request, or to read or decode
+// a response body) are returned as non-retryable [temporal.ApplicationError]s. HTTP
+// error status codes are returned as [temporal.ApplicationError]s whose cause is an
+// [HTTPError].
+//
+// [temporal.ApplicationError]: https://pkg.go.dev/go.temporal.io/temporal#ApplicationError
+func (c *Client) Request(ctx context.Context, httpMethod, u, authToken string, queryOrBody any) ([]byte, error) {
+	return c.AuthenticatedRequest(ctx, httpMethod, u, BearerToken(authToken), queryOrBody)
+}
 
-// HTTPRequest sends an HTTP GET or POST request to an external API.
-// For GET requests, the queryOrJSONBody parameter is expected to be
-// [url.Values]. For POST requests, it should be any struct that can be
-// encoded as JSON. Some errors (failure to construct a request or decode
-// a response body) are returned as non-retryable [temporal.ApplicationError]s.
+// AuthenticatedRequest is identical to [Client.Request], except that the request is
+// authenticated with the given [Authenticator] (or not at all, if it's nil) instead
+// of a bearer token. Authentication errors are returned as non-retryable
+// [temporal.ApplicationError]s.
 //
 // [temporal.ApplicationError]: https://pkg.go.dev/go.temporal.io/temporal#ApplicationError
-func HTTPRequest(ctx context.Context, httpMethod, u, authToken string, queryOrJSONBody any) ([]byte, error) {
-	req, cancel, err := constructRequest(ctx, httpMethod, u, authToken, queryOrJSONBody)
+func (c *Client) AuthenticatedRequest(ctx context.Context, httpMethod, u string, auth Authenticator, queryOrBody any) ([]byte, error) {
+	req, cancel, err := c.constructRequest(ctx, httpMethod, u, auth, queryOrBody)
 	if err != nil {
 		return nil, err
 	}
 	defer cancel()
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send HTTP request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSize))
+	body, err := io.ReadAll(io.LimitReader(resp.Body, c.maxResponseSize+1))
 	if err != nil {
 		return nil, fmt.Errorf("failed to read HTTP response body: %w", err)
 	}
+	if int64(len(body)) > c.maxResponseSize {
+		msg := fmt.Sprintf("HTTP response body is larger than %d bytes", c.maxResponseSize)
+		return nil, temporal.NewNonRetryableApplicationError(msg, "error", nil, u)
+	}
 
 	if resp.StatusCode >= http.StatusBadRequest {
-		msg := resp.Status
-		if len(body) > 0 {
-			msg = fmt.Sprintf("%s: %s", msg, string(body))
-		}
-		return nil, errors.New(msg)
+		return nil, httpError(resp, body, time.Now())
 	}
 
 	return body, nil
 }
 
-func constructRequest(ctx context.Context, method, u, token string, queryOrJSONBody any) (*http.Request, context.CancelFunc, error) {
-	if method == http.MethodGet {
-		u = fmt.Sprintf("%s?%s", u, queryOrJSONBody.(url.Values).Encode())
+// httpError converts an HTTP error response into a retryable [temporal.ApplicationError].
+// If the response specifies how long to wait before retrying, so does the error.
+func httpError(resp *http.Response, body []byte, now time.Time) error {
+	err := &HTTPError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       string(body),
+		RetryAfter: retryAfter(resp.Header.Get("Retry-After"), now),
+	}
+
+	return temporal.NewApplicationErrorWithOptions(err.Error(), fmt.Sprintf("%T", err), temporal.ApplicationErrorOptions{
+		Cause:          err,
+		Details:        []any{err},
+		NextRetryDelay: err.RetryAfter,
+	})
+}
+
+// retryAfter parses the value of an HTTP "Retry-After" header, which may be
+// either a number of seconds or an HTTP date (https://www.rfc-editor.org/rfc/rfc9110#name-retry-after).
+// It returns 0 if the value is missing, invalid, or already in the past.
+func retryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
 	}
 
-	b, err := requestBody(method, queryOrJSONBody)
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+
+	t, err := http.ParseTime(value)
+	if err != nil || !t.After(now) {
+		return 0
+	}
+	return t.Sub(now)
+}
+
+func (c *Client) constructRequest(ctx context.Context, method, u string, auth Authenticator, queryOrBody any) (*http.Request, context.CancelFunc, error) {
+	u, b, contentType, err := encodeRequest(method, u, queryOrBody)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.requestTimeout(ctx))
 	req, err := http.NewRequestWithContext(ctx, method, u, b)
 	if err != nil {
 		cancel()
@@ -77,23 +202,163 @@ func constructRequest(ctx context.Context, method, u, token string, queryOrJSONB
 	}
 
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	if auth != nil {
+		if err := auth.Authenticate(req); err != nil {
+			cancel()
+			msg := "failed to authenticate HTTP request: " + err.Error()
+			return nil, nil, temporal.NewNonRetryableApplicationError(msg, fmt.Sprintf("%T", err), err)
+		}
+	}
 
 	return req, cancel, nil
 }
 
-func requestBody(method string, queryOrJSONBody any) (io.Reader, error) {
-	if method == http.MethodGet {
-		return http.NoBody, nil
+// encodeRequest returns the URL and the encoded body of an HTTP request,
+// and the body's content type, after checking that they match the method.
+func encodeRequest(method, u string, queryOrBody any) (string, io.Reader, string, error) {
+	switch method {
+	case http.MethodGet, http.MethodDelete:
+		switch q := queryOrBody.(type) {
+		case nil:
+			return u, http.NoBody, "", nil
+		case url.Values:
+			return withQuery(u, q), http.NoBody, "", nil
+		}
+		if method == http.MethodGet {
+			return "", nil, "", requestError(fmt.Sprintf("HTTP GET request expects url.Values, not %T", queryOrBody))
+		}
+
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		if _, ok := queryOrBody.(url.Values); ok {
+			msg := fmt.Sprintf("HTTP %s request body can't be url.Values, use FormBody or JSONBody", method)
+			return "", nil, "", requestError(msg)
+		}
+
+	default:
+		return "", nil, "", requestError("unsupported HTTP request method: " + method)
 	}
 
-	// HTTP POST.
-	b, err := json.Marshal(queryOrJSONBody)
+	b, contentType, err := requestBody(queryOrBody)
+	return u, b, contentType, err
+}
+
+// withQuery returns the given URL with additional query parameters.
+func withQuery(u string, query url.Values) string {
+	q := query.Encode()
+	switch {
+	case q == "":
+		return u
+	case strings.Contains(u, "?"):
+		return u + "&" + q
+	default:
+		return u + "?" + q
+	}
+}
+
+// requestBody returns the encoded body of an HTTP request, and its content type.
+func requestBody(body any) (io.Reader, string, error) {
+	switch b := body.(type) {
+	case nil:
+		return http.NoBody, "", nil
+	case JSONBody:
+		return jsonBody(b.Value)
+	case *JSONBody:
+		if b != nil {
+			return jsonBody(b.Value)
+		}
+	case FormBody:
+		return strings.NewReader(url.Values(b).Encode()), "application/x-www-form-urlencoded", nil
+	case *FormBody:
+		if b != nil {
+			return strings.NewReader(url.Values(*b).Encode()), "application/x-www-form-urlencoded", nil
+		}
+	case RawBody:
+		return rawBody(&b)
+	case *RawBody:
+		if b != nil {
+			return rawBody(b)
+		}
+	case MultipartBody:
+		return multipartBody(&b)
+	case *MultipartBody:
+		if b != nil {
+			return multipartBody(b)
+		}
+	default:
+		return jsonBody(body)
+	}
+
+	return nil, "", requestError(fmt.Sprintf("HTTP request body is a nil %T", body))
+}
+
+func jsonBody(v any) (io.Reader, string, error) {
+	b, err := json.Marshal(v)
 	if err != nil {
 		msg := "failed to encode HTTP request's JSON body: " + err.Error()
-		return nil, temporal.NewNonRetryableApplicationError(msg, fmt.Sprintf("%T", err), err)
+		return nil, "", temporal.NewNonRetryableApplicationError(msg, fmt.Sprintf("%T", err), err)
 	}
 
-	return bytes.NewReader(b), nil
+	return bytes.NewReader(b), "application/json; charset=utf-8", nil
+}
+
+func rawBody(body *RawBody) (io.Reader, string, error) {
+	contentType := body.ContentType
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+	return bytes.NewReader(body.Data), contentType, nil
+}
+
+func multipartBody(body *MultipartBody) (io.Reader, string, error) {
+	b := new(bytes.Buffer)
+	w := multipart.NewWriter(b)
+
+	for _, k := range slices.Sorted(maps.Keys(body.Fields)) {
+		if err := w.WriteField(k, body.Fields[k]); err != nil {
+			return nil, "", multipartError(err)
+		}
+	}
+
+	for _, f := range body.Files {
+		contentType := f.ContentType
+		if contentType == "" {
+			contentType = defaultContentType
+		}
+
+		h := textproto.MIMEHeader{}
+		h.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{
+			"name": f.FieldName, "filename": f.FileName,
+		}))
+		h.Set("Content-Type", contentType)
+
+		fw, err := w.CreatePart(h)
+		if err != nil {
+			return nil, "", multipartError(err)
+		}
+		if _, err := fw.Write(f.Data); err != nil {
+			return nil, "", multipartError(err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, "", multipartError(err)
+	}
+
+	return b, w.FormDataContentType(), nil
+}
+
+func multipartError(err error) error {
+	msg := "failed to encode HTTP request's multipart body: " + err.Error()
+	return temporal.NewNonRetryableApplicationError(msg, fmt.Sprintf("%T", err), err)
+}
+
+func requestError(msg string) error {
+	return temporal.NewNonRetryableApplicationError(msg, "error", nil)
 }
@@ -1,11 +1,17 @@
 package client
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
 )
 
 func TestHTTPRequest(t *testing.T) {
@@ -57,6 +63,334 @@ func TestHTTPRequest(t *testing.T) {
 	}
 }
 
+func TestHTTPRequestBodies(t *testing.T) {
+	tests := []struct {
+		name            string
+		body            any
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:            "json",
+			body:            map[string]string{"k": "v"},
+			wantContentType: "application/json; charset=utf-8",
+			wantBody:        `{"k":"v"}`,
+		},
+		{
+			name:            "raw",
+			body:            RawBody{ContentType: "text/plain", Data: []byte("data")},
+			wantContentType: "text/plain",
+			wantBody:        "data",
+		},
+		{
+			name:            "explicit_json",
+			body:            JSONBody{Value: url.Values{"k": []string{"v"}}},
+			wantContentType: "application/json; charset=utf-8",
+			wantBody:        `{"k":["v"]}`,
+		},
+		{
+			name:            "form",
+			body:            FormBody{"k": []string{"v1", "v2"}, "a": []string{"b c"}},
+			wantContentType: "application/x-www-form-urlencoded",
+			wantBody:        "a=b+c&k=v1&k=v2",
+		},
+		{
+			name:            "raw_without_content_type",
+			body:            &RawBody{Data: []byte("data")},
+			wantContentType: "application/octet-stream",
+			wantBody:        "data",
+		},
+		{
+			name: "multipart",
+			body: &MultipartBody{
+				Fields: map[string]string{"k": "v"},
+				Files:  []MultipartFile{{FieldName: "file", FileName: "a.txt", Data: []byte("data")}},
+			},
+			wantContentType: "multipart/form-data",
+			wantBody:        "k=v, file=a.txt:application/octet-stream:data",
+		},
+		{
+			name: "multipart_with_content_type",
+			body: MultipartBody{
+				Files: []MultipartFile{{FieldName: "file", FileName: "a b.txt", ContentType: "text/plain", Data: []byte("data")}},
+			},
+			wantContentType: "multipart/form-data",
+			wantBody:        "k=, file=a b.txt:text/plain:data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "" {
+					t.Errorf("unexpected authorization header: %q", r.Header.Get("Authorization"))
+				}
+
+				ct := r.Header.Get("Content-Type")
+				if !strings.HasPrefix(ct, tt.wantContentType) {
+					t.Errorf("content type header = %q, want %q", ct, tt.wantContentType)
+				}
+
+				if !strings.HasPrefix(ct, "multipart/") {
+					_, _ = io.Copy(w, r.Body)
+					return
+				}
+
+				if err := r.ParseMultipartForm(1 << 20); err != nil {
+					t.Errorf("failed to parse multipart form: %v", err)
+					return
+				}
+				f, h, err := r.FormFile("file")
+				if err != nil {
+					t.Errorf("failed to read multipart file: %v", err)
+					return
+				}
+				defer f.Close()
+				data, _ := io.ReadAll(f)
+				fmt.Fprintf(w, "k=%s, file=%s:%s:%s", r.FormValue("k"), h.Filename, h.Header.Get("Content-Type"), data)
+			}))
+			defer s.Close()
+
+			got, err := HTTPRequest(t.Context(), http.MethodPost, s.URL, "", tt.body)
+			if err != nil {
+				t.Fatalf("HTTPRequest() error = %v", err)
+			}
+			if string(got) != tt.wantBody {
+				t.Errorf("HTTPRequest() = %q, want %q", got, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHTTPRequestMethods(t *testing.T) {
+	tests := []struct {
+		name       string
+		httpMethod string
+		body       any
+		wantQuery  string
+		wantBody   string
+	}{
+		{
+			name:       "get_without_query",
+			httpMethod: http.MethodGet,
+		},
+		{
+			name:       "get_with_query",
+			httpMethod: http.MethodGet,
+			body:       url.Values{"k": []string{"v"}},
+			wantQuery:  "k=v",
+		},
+		{
+			name:       "put",
+			httpMethod: http.MethodPut,
+			body:       map[string]string{"k": "v"},
+			wantBody:   `{"k":"v"}`,
+		},
+		{
+			name:       "patch",
+			httpMethod: http.MethodPatch,
+			body:       FormBody{"k": []string{"v"}},
+			wantBody:   "k=v",
+		},
+		{
+			name:       "post_without_body",
+			httpMethod: http.MethodPost,
+		},
+		{
+			name:       "delete_with_query",
+			httpMethod: http.MethodDelete,
+			body:       url.Values{"k": []string{"v"}},
+			wantQuery:  "k=v",
+		},
+		{
+			name:       "delete_with_body",
+			httpMethod: http.MethodDelete,
+			body:       RawBody{ContentType: "text/plain", Data: []byte("data")},
+			wantBody:   "data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tt.httpMethod {
+					t.Errorf("method = %q, want %q", r.Method, tt.httpMethod)
+				}
+				if r.URL.RawQuery != tt.wantQuery {
+					t.Errorf("query = %q, want %q", r.URL.RawQuery, tt.wantQuery)
+				}
+				_, _ = io.Copy(w, r.Body)
+			}))
+			defer s.Close()
+
+			got, err := HTTPRequest(t.Context(), tt.httpMethod, s.URL, "", tt.body)
+			if err != nil {
+				t.Fatalf("HTTPRequest() error = %v", err)
+			}
+			if string(got) != tt.wantBody {
+				t.Errorf("HTTPRequest() = %q, want %q", got, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHTTPRequestMismatchedInputs(t *testing.T) {
+	tests := []struct {
+		name       string
+		httpMethod string
+		body       any
+	}{
+		{
+			name:       "get_with_body",
+			httpMethod: http.MethodGet,
+			body:       map[string]string{"k": "v"},
+		},
+		{
+			name:       "post_with_query",
+			httpMethod: http.MethodPost,
+			body:       url.Values{"k": []string{"v"}},
+		},
+		{
+			name:       "nil_raw_body",
+			httpMethod: http.MethodPut,
+			body:       (*RawBody)(nil),
+		},
+		{
+			name:       "unencodable_json",
+			httpMethod: http.MethodPost,
+			body:       map[string]any{"k": make(chan int)},
+		},
+		{
+			name:       "unsupported_method",
+			httpMethod: http.MethodHead,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Error("unexpected HTTP request")
+			}))
+			defer s.Close()
+
+			_, err := HTTPRequest(t.Context(), tt.httpMethod, s.URL, "", tt.body)
+			appErr := new(temporal.ApplicationError)
+			if !errors.As(err, &appErr) {
+				t.Fatalf("HTTPRequest() error = %v, want *temporal.ApplicationError", err)
+			}
+			if !appErr.NonRetryable() {
+				t.Errorf("HTTPRequest() error is retryable")
+			}
+		})
+	}
+}
+
+func TestHTTPRequestErrorStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		retryAfter string
+		wantDelay  time.Duration
+	}{
+		{
+			name:   "bad_request",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:       "too_many_requests",
+			status:     http.StatusTooManyRequests,
+			retryAfter: "30",
+			wantDelay:  30 * time.Second,
+		},
+		{
+			name:       "service_unavailable",
+			status:     http.StatusServiceUnavailable,
+			retryAfter: "invalid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, "error")
+			}))
+			defer s.Close()
+
+			_, err := HTTPRequest(t.Context(), http.MethodGet, s.URL, "token", url.Values{})
+
+			appErr := new(temporal.ApplicationError)
+			if !errors.As(err, &appErr) {
+				t.Fatalf("HTTPRequest() error = %v, want *temporal.ApplicationError", err)
+			}
+			if appErr.NonRetryable() {
+				t.Errorf("HTTPRequest() error is non-retryable")
+			}
+			if got := appErr.NextRetryDelay(); got != tt.wantDelay {
+				t.Errorf("HTTPRequest() error NextRetryDelay() = %v, want %v", got, tt.wantDelay)
+			}
+
+			httpErr := new(HTTPError)
+			if !errors.As(err, &httpErr) {
+				t.Fatalf("HTTPRequest() error = %v, want *HTTPError", err)
+			}
+			if httpErr.StatusCode != tt.status {
+				t.Errorf("HTTPError.StatusCode = %d, want %d", httpErr.StatusCode, tt.status)
+			}
+			if httpErr.Body != "error" {
+				t.Errorf("HTTPError.Body = %q, want %q", httpErr.Body, "error")
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:  "seconds",
+			value: "120",
+			want:  2 * time.Minute,
+		},
+		{
+			name:  "negative_seconds",
+			value: "-1",
+		},
+		{
+			name:  "http_date",
+			value: "Wed, 01 Jan 2025 00:01:30 GMT",
+			want:  90 * time.Second,
+		},
+		{
+			name:  "http_date_in_the_past",
+			value: "Tue, 31 Dec 2024 23:59:00 GMT",
+		},
+		{
+			name:  "invalid",
+			value: "soon",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryAfter(tt.value, now); got != tt.want {
+				t.Errorf("retryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func handler(t *testing.T) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		got := r.Header.Get("Accept")
@@ -0,0 +1,560 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// Admin API methods are available only in Enterprise Grid organizations,
+// and require an org-level user token of an admin or owner (see [OrgToken]):
+// https://docs.slack.dev/admins/managing-users-and-workspaces
+const (
+	AdminConversationsArchiveName     = "slack.admin.conversations.archive"
+	AdminConversationsCreateName      = "slack.admin.conversations.create"
+	AdminConversationsSearchName      = "slack.admin.conversations.search"
+	AdminConversationsSearchAllName   = "slack.admin.conversations.search.all"
+	AdminConversationsSetTeamsName    = "slack.admin.conversations.setTeams"
+	AdminTeamsListName                = "slack.admin.teams.list"
+	AdminTeamsListAllName             = "slack.admin.teams.list.all"
+	AdminUsergroupsAddChannelsName    = "slack.admin.usergroups.addChannels"
+	AdminUsergroupsAddTeamsName       = "slack.admin.usergroups.addTeams"
+	AdminUsergroupsListChannelsName   = "slack.admin.usergroups.listChannels"
+	AdminUsergroupsRemoveChannelsName = "slack.admin.usergroups.removeChannels"
+	AdminUsersAssignName              = "slack.admin.users.assign"
+	AdminUsersInviteName              = "slack.admin.users.invite"
+	AdminUsersRemoveName              = "slack.admin.users.remove"
+	AdminUsersSetAdminName            = "slack.admin.users.setAdmin"
+)
+
+// AdminConversation is a Slack conversation in the results of
+// [API.AdminConversationsSearchActivity]. Unlike [Channel], its purpose
+// is a string. Fields which aren't defined explicitly in this struct
+// are preserved in Extra.
+//
+// https://docs.slack.dev/reference/methods/admin.conversations.search
+type AdminConversation struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Purpose     string `json:"purpose,omitempty"`
+	MemberCount int    `json:"member_count,omitempty"`
+	Created     int64  `json:"created,omitempty"`
+	CreatorID   string `json:"creator_id,omitempty"`
+
+	IsPrivate      bool `json:"is_private,omitempty"`
+	IsArchived     bool `json:"is_archived,omitempty"`
+	IsGeneral      bool `json:"is_general,omitempty"`
+	IsExtShared    bool `json:"is_ext_shared,omitempty"`
+	IsOrgShared    bool `json:"is_org_shared,omitempty"`
+	IsOrgDefault   bool `json:"is_org_default,omitempty"`
+	IsOrgMandatory bool `json:"is_org_mandatory,omitempty"`
+
+	ConnectedTeamIDs []string `json:"connected_team_ids,omitempty"`
+	InternalTeamIDs  []string `json:"internal_team_ids,omitempty"`
+	LastActivityTS   string   `json:"last_activity_ts,omitempty"`
+
+	Extra map[string]any `json:"-"`
+}
+
+func (c *AdminConversation) UnmarshalJSON(b []byte) error {
+	type adminConversation AdminConversation
+	extra, err := unmarshalWithExtra(b, (*adminConversation)(c))
+	c.Extra = extra
+	return err
+}
+
+func (c AdminConversation) MarshalJSON() ([]byte, error) {
+	type adminConversation AdminConversation
+	return marshalWithExtra(adminConversation(c), c.Extra)
+}
+
+// https://docs.slack.dev/reference/methods/admin.conversations.archive
+type AdminConversationsArchiveRequest struct {
+	CallOptions
+
+	ChannelID string `json:"channel_id"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.conversations.archive
+type AdminConversationsArchiveResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/admin.conversations.archive
+func (a *API) AdminConversationsArchiveActivity(ctx context.Context, req *AdminConversationsArchiveRequest) (*AdminConversationsArchiveResponse, error) {
+	resp := new(AdminConversationsArchiveResponse)
+	if err := a.httpPost(ctx, AdminConversationsArchiveName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// TeamID is required if OrgWide is false.
+//
+// https://docs.slack.dev/reference/methods/admin.conversations.create
+type AdminConversationsCreateRequest struct {
+	CallOptions
+
+	IsPrivate bool   `json:"is_private"`
+	Name      string `json:"name"`
+
+	Description string `json:"description,omitempty"`
+	OrgWide     bool   `json:"org_wide,omitempty"`
+	TeamID      string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.conversations.create
+type AdminConversationsCreateResponse struct {
+	slackResponse
+
+	ChannelID string `json:"channel_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.conversations.create
+func (a *API) AdminConversationsCreateActivity(ctx context.Context, req *AdminConversationsCreateRequest) (*AdminConversationsCreateResponse, error) {
+	resp := new(AdminConversationsCreateResponse)
+	if err := a.httpPost(ctx, AdminConversationsCreateName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// ConnectedTeamIDs, SearchChannelTypes and TeamIDs are comma-separated lists.
+//
+// https://docs.slack.dev/reference/methods/admin.conversations.search
+type AdminConversationsSearchRequest struct {
+	CallOptions
+
+	ConnectedTeamIDs   string `json:"connected_team_ids,omitempty"`
+	Cursor             string `json:"cursor,omitempty"`
+	Limit              int    `json:"limit,omitempty"`
+	Query              string `json:"query,omitempty"`
+	SearchChannelTypes string `json:"search_channel_types,omitempty"`
+	Sort               string `json:"sort,omitempty"`
+	SortDir            string `json:"sort_dir,omitempty"`
+	TeamIDs            string `json:"team_ids,omitempty"`
+	TotalCountOnly     bool   `json:"total_count_only,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.conversations.search
+type AdminConversationsSearchResponse struct {
+	slackResponse
+
+	Conversations []AdminConversation `json:"conversations,omitempty"`
+	NextCursor    string              `json:"next_cursor,omitempty"`
+	TotalCount    int                 `json:"total_count,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.conversations.search
+func (a *API) AdminConversationsSearchActivity(ctx context.Context, req *AdminConversationsSearchRequest) (*AdminConversationsSearchResponse, error) {
+	query := url.Values{}
+	if req.ConnectedTeamIDs != "" {
+		query.Set("connected_team_ids", req.ConnectedTeamIDs)
+	}
+	if req.Cursor != "" {
+		query.Set("cursor", req.Cursor)
+	}
+	if req.Limit != 0 {
+		query.Set("limit", strconv.Itoa(req.Limit))
+	}
+	if req.Query != "" {
+		query.Set("query", req.Query)
+	}
+	if req.SearchChannelTypes != "" {
+		query.Set("search_channel_types", req.SearchChannelTypes)
+	}
+	if req.Sort != "" {
+		query.Set("sort", req.Sort)
+	}
+	if req.SortDir != "" {
+		query.Set("sort_dir", req.SortDir)
+	}
+	if req.TeamIDs != "" {
+		query.Set("team_ids", req.TeamIDs)
+	}
+	if req.TotalCountOnly {
+		query.Set("total_count_only", "true")
+	}
+
+	resp := new(AdminConversationsSearchResponse)
+	if err := a.httpGet(ctx, AdminConversationsSearchName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/admin.conversations.search
+type AdminConversationsSearchAllRequest struct {
+	AdminConversationsSearchRequest
+
+	MaxItems int `json:"max_items,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.conversations.search
+type AdminConversationsSearchAllResponse struct {
+	Conversations []AdminConversation `json:"conversations,omitempty"`
+	NextCursor    string              `json:"next_cursor,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.conversations.search
+func (a *API) AdminConversationsSearchAllActivity(ctx context.Context, req *AdminConversationsSearchAllRequest) (*AdminConversationsSearchAllResponse, error) {
+	items, next, err := paginate(ctx, req.Cursor, req.MaxItems, func(ctx context.Context, cursor string) ([]AdminConversation, string, error) {
+		page := req.AdminConversationsSearchRequest
+		page.Cursor = cursor
+		resp, err := a.AdminConversationsSearchActivity(ctx, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Conversations, resp.NextCursor, nil // Not in the response metadata.
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AdminConversationsSearchAllResponse{Conversations: items, NextCursor: next}, nil
+}
+
+// TargetTeamIDs is a comma-separated list.
+//
+// https://docs.slack.dev/reference/methods/admin.conversations.setTeams
+type AdminConversationsSetTeamsRequest struct {
+	CallOptions
+
+	ChannelID string `json:"channel_id"`
+
+	OrgChannel    bool   `json:"org_channel,omitempty"`
+	TargetTeamIDs string `json:"target_team_ids,omitempty"`
+	TeamID        string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.conversations.setTeams
+type AdminConversationsSetTeamsResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/admin.conversations.setTeams
+func (a *API) AdminConversationsSetTeamsActivity(ctx context.Context, req *AdminConversationsSetTeamsRequest) (*AdminConversationsSetTeamsResponse, error) {
+	resp := new(AdminConversationsSetTeamsResponse)
+	if err := a.httpPost(ctx, AdminConversationsSetTeamsName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/admin.teams.list
+type AdminTeamsListRequest struct {
+	CallOptions
+
+	Cursor string `json:"cursor,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.teams.list
+type AdminTeamsListResponse struct {
+	slackResponse
+
+	Teams []Team `json:"teams,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.teams.list
+func (a *API) AdminTeamsListActivity(ctx context.Context, req *AdminTeamsListRequest) (*AdminTeamsListResponse, error) {
+	query := url.Values{}
+	if req.Cursor != "" {
+		query.Set("cursor", req.Cursor)
+	}
+	if req.Limit != 0 {
+		query.Set("limit", strconv.Itoa(req.Limit))
+	}
+
+	resp := new(AdminTeamsListResponse)
+	if err := a.httpGet(ctx, AdminTeamsListName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/admin.teams.list
+type AdminTeamsListAllRequest struct {
+	AdminTeamsListRequest
+
+	MaxItems int `json:"max_items,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.teams.list
+type AdminTeamsListAllResponse struct {
+	Teams      []Team `json:"teams,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.teams.list
+func (a *API) AdminTeamsListAllActivity(ctx context.Context, req *AdminTeamsListAllRequest) (*AdminTeamsListAllResponse, error) {
+	items, next, err := paginate(ctx, req.Cursor, req.MaxItems, func(ctx context.Context, cursor string) ([]Team, string, error) {
+		page := req.AdminTeamsListRequest
+		page.Cursor = cursor
+		resp, err := a.AdminTeamsListActivity(ctx, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Teams, resp.nextCursor(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AdminTeamsListAllResponse{Teams: items, NextCursor: next}, nil
+}
+
+// ChannelIDs is a comma-separated list.
+//
+// https://docs.slack.dev/reference/methods/admin.usergroups.addChannels
+type AdminUsergroupsAddChannelsRequest struct {
+	CallOptions
+
+	ChannelIDs  string `json:"channel_ids"`
+	UsergroupID string `json:"usergroup_id"`
+
+	TeamID string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.usergroups.addChannels
+type AdminUsergroupsAddChannelsResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/admin.usergroups.addChannels
+func (a *API) AdminUsergroupsAddChannelsActivity(ctx context.Context, req *AdminUsergroupsAddChannelsRequest) (*AdminUsergroupsAddChannelsResponse, error) {
+	resp := new(AdminUsergroupsAddChannelsResponse)
+	if err := a.httpPost(ctx, AdminUsergroupsAddChannelsName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// TeamIDs is a comma-separated list.
+//
+// https://docs.slack.dev/reference/methods/admin.usergroups.addTeams
+type AdminUsergroupsAddTeamsRequest struct {
+	CallOptions
+
+	TeamIDs     string `json:"team_ids"`
+	UsergroupID string `json:"usergroup_id"`
+
+	AutoProvision bool `json:"auto_provision,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.usergroups.addTeams
+type AdminUsergroupsAddTeamsResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/admin.usergroups.addTeams
+func (a *API) AdminUsergroupsAddTeamsActivity(ctx context.Context, req *AdminUsergroupsAddTeamsRequest) (*AdminUsergroupsAddTeamsResponse, error) {
+	resp := new(AdminUsergroupsAddTeamsResponse)
+	if err := a.httpPost(ctx, AdminUsergroupsAddTeamsName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/admin.usergroups.listChannels
+type AdminUsergroupsListChannelsRequest struct {
+	CallOptions
+
+	UsergroupID string `json:"usergroup_id"`
+
+	IncludeNumMembers bool   `json:"include_num_members,omitempty"`
+	TeamID            string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.usergroups.listChannels
+type AdminUsergroupsListChannelsResponse struct {
+	slackResponse
+
+	Channels []Channel `json:"channels,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.usergroups.listChannels
+func (a *API) AdminUsergroupsListChannelsActivity(ctx context.Context, req *AdminUsergroupsListChannelsRequest) (*AdminUsergroupsListChannelsResponse, error) {
+	query := url.Values{}
+	query.Set("usergroup_id", req.UsergroupID)
+	if req.IncludeNumMembers {
+		query.Set("include_num_members", "true")
+	}
+	if req.TeamID != "" {
+		query.Set("team_id", req.TeamID)
+	}
+
+	resp := new(AdminUsergroupsListChannelsResponse)
+	if err := a.httpGet(ctx, AdminUsergroupsListChannelsName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// ChannelIDs is a comma-separated list.
+//
+// https://docs.slack.dev/reference/methods/admin.usergroups.removeChannels
+type AdminUsergroupsRemoveChannelsRequest struct {
+	CallOptions
+
+	ChannelIDs  string `json:"channel_ids"`
+	UsergroupID string `json:"usergroup_id"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.usergroups.removeChannels
+type AdminUsergroupsRemoveChannelsResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/admin.usergroups.removeChannels
+func (a *API) AdminUsergroupsRemoveChannelsActivity(ctx context.Context, req *AdminUsergroupsRemoveChannelsRequest) (*AdminUsergroupsRemoveChannelsResponse, error) {
+	resp := new(AdminUsergroupsRemoveChannelsResponse)
+	if err := a.httpPost(ctx, AdminUsergroupsRemoveChannelsName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// ChannelIDs is a comma-separated list.
+//
+// https://docs.slack.dev/reference/methods/admin.users.assign
+type AdminUsersAssignRequest struct {
+	CallOptions
+
+	TeamID string `json:"team_id"`
+	UserID string `json:"user_id"`
+
+	ChannelIDs        string `json:"channel_ids,omitempty"`
+	IsRestricted      bool   `json:"is_restricted,omitempty"`
+	IsUltraRestricted bool   `json:"is_ultra_restricted,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.users.assign
+type AdminUsersAssignResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/admin.users.assign
+func (a *API) AdminUsersAssignActivity(ctx context.Context, req *AdminUsersAssignRequest) (*AdminUsersAssignResponse, error) {
+	resp := new(AdminUsersAssignResponse)
+	if err := a.httpPost(ctx, AdminUsersAssignName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// ChannelIDs is a comma-separated list.
+//
+// https://docs.slack.dev/reference/methods/admin.users.invite
+type AdminUsersInviteRequest struct {
+	CallOptions
+
+	ChannelIDs string `json:"channel_ids"`
+	Email      string `json:"email"`
+	TeamID     string `json:"team_id"`
+
+	CustomMessage              string `json:"custom_message,omitempty"`
+	EmailPasswordPolicyEnabled bool   `json:"email_password_policy_enabled,omitempty"`
+	GuestExpirationTS          string `json:"guest_expiration_ts,omitempty"`
+	IsRestricted               bool   `json:"is_restricted,omitempty"`
+	IsUltraRestricted          bool   `json:"is_ultra_restricted,omitempty"`
+	RealName                   string `json:"real_name,omitempty"`
+	Resend                     bool   `json:"resend,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.users.invite
+type AdminUsersInviteResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/admin.users.invite
+func (a *API) AdminUsersInviteActivity(ctx context.Context, req *AdminUsersInviteRequest) (*AdminUsersInviteResponse, error) {
+	resp := new(AdminUsersInviteResponse)
+	if err := a.httpPost(ctx, AdminUsersInviteName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/admin.users.remove
+type AdminUsersRemoveRequest struct {
+	CallOptions
+
+	TeamID string `json:"team_id"`
+	UserID string `json:"user_id"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.users.remove
+type AdminUsersRemoveResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/admin.users.remove
+func (a *API) AdminUsersRemoveActivity(ctx context.Context, req *AdminUsersRemoveRequest) (*AdminUsersRemoveResponse, error) {
+	resp := new(AdminUsersRemoveResponse)
+	if err := a.httpPost(ctx, AdminUsersRemoveName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/admin.users.setAdmin
+type AdminUsersSetAdminRequest struct {
+	CallOptions
+
+	TeamID string `json:"team_id"`
+	UserID string `json:"user_id"`
+}
+
+// https://docs.slack.dev/reference/methods/admin.users.setAdmin
+type AdminUsersSetAdminResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/admin.users.setAdmin
+func (a *API) AdminUsersSetAdminActivity(ctx context.Context, req *AdminUsersSetAdminRequest) (*AdminUsersSetAdminResponse, error) {
+	resp := new(AdminUsersSetAdminResponse)
+	if err := a.httpPost(ctx, AdminUsersSetAdminName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
@@ -8,40 +8,58 @@ import (
 	"net/url"
 	"strings"
 
-	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/log"
 	"go.temporal.io/sdk/temporal"
 
+	"github.com/tzrikka/ovid/internal/logger"
 	"github.com/tzrikka/ovid/pkg/client"
 )
 
+// slowMethods use the HTTP client's slow timeout instead of its default
+// one, because they may take longer than usual (see [client.WithSlowTimeout]).
+var slowMethods = map[string]bool{
+	AdminConversationsSearchName:    true,
+	ConversationsHistoryName:        true,
+	ConversationsRepliesName:        true,
+	FilesCompleteUploadExternalName: true,
+	SearchAllName:                   true,
+	SearchFilesName:                 true,
+	SearchMessagesName:              true,
+}
+
 type slackResponse struct {
 	OK               bool              `json:"ok"`
 	Error            string            `json:"error,omitempty"`
 	Needed           string            `json:"needed,omitempty"`   // Scope errors (undocumented).
 	Provided         string            `json:"provided,omitempty"` // Scope errors (undocumented).
 	Warning          string            `json:"warning,omitempty"`
-	ResponseMetadata *responseMetadata `json:"response_metadata,omitempty"`
+	ResponseMetadata *ResponseMetadata `json:"response_metadata,omitempty"`
 }
 
-type responseMetadata struct {
+type ResponseMetadata struct {
 	Messages   []string `json:"messages,omitempty"`
 	Warnings   []string `json:"warnings,omitempty"`
 	NextCursor string   `json:"next_cursor,omitempty"`
 }
 
-func (a *API) httpRequestPrep(ctx context.Context, urlSuffix string) (l log.Logger, apiURL, botToken string, err error) {
-	l = activity.GetLogger(ctx)
+func (a *API) httpRequestPrep(ctx context.Context, urlSuffix string) (l log.Logger, linkID, apiURL string, auth client.Authenticator, err error) {
+	l = logger.FromContext(ctx)
+
+	t := a.link(ctx)
+	linkID = t.LinkID
 
 	var template string
 	var secrets map[string]string
-	template, secrets, err = a.thrippy.LinkData(ctx, "slack")
+	template, secrets, err = a.data(ctx, t)
 	if err != nil {
 		return
 	}
 
 	urlBase := "https://slack.com"
-	if template == "slack-oauth-gov" {
+	switch {
+	case a.baseURL != "":
+		urlBase = a.baseURL
+	case template == "slack-oauth-gov":
 		urlBase = "https://slack-gov.com" // https://docs.slack.dev/govslack
 	}
 
@@ -53,14 +71,19 @@ func (a *API) httpRequestPrep(ctx context.Context, urlSuffix string) (l log.Logg
 		return
 	}
 
-	botToken = secrets["bot_token"]
-	if botToken == "" {
-		botToken = secrets["access_token"] // OAuth token, possibly short-lived.
+	tt := tokenType(ctx, urlSuffix)
+	switch tt {
+	case BotToken, UserToken, AppToken, OrgToken:
+	default:
+		msg := "invalid Slack token type: " + string(tt)
+		l.Error(msg, "url_suffix", urlSuffix)
+		err = temporal.NewNonRetryableApplicationError(msg, "error", nil, tt)
+		return
 	}
-	if botToken == "" {
-		msg := "Slack bot token not found in Thrippy link credentials"
-		l.Warn(msg, "link_id", a.thrippy.LinkID)
-		err = temporal.NewNonRetryableApplicationError(msg, "error", nil, a.thrippy.LinkID)
+
+	auth, err = client.LinkAuthenticator(template, secrets, string(tt))
+	if err != nil {
+		l.Warn("failed to authenticate Slack API call", "error", err.Error(), "link_id", linkID, "url_suffix", urlSuffix)
 		return
 	}
 
@@ -68,12 +91,16 @@ func (a *API) httpRequestPrep(ctx context.Context, urlSuffix string) (l log.Logg
 }
 
 func (a *API) httpGet(ctx context.Context, urlSuffix string, query url.Values, jsonResp any) error {
-	l, apiURL, botToken, err := a.httpRequestPrep(ctx, urlSuffix)
+	l, linkID, apiURL, auth, err := a.httpRequestPrep(ctx, urlSuffix)
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.HTTPRequest(ctx, http.MethodGet, apiURL, botToken, query)
+	if err := a.limiter.wait(ctx, linkID, urlSuffix, ""); err != nil {
+		return err
+	}
+
+	resp, err := a.client.AuthenticatedRequest(a.requestContext(ctx, urlSuffix), http.MethodGet, apiURL, auth, query)
 	if err != nil {
 		l.Error("HTTP GET request error", "error", err.Error(), "url", apiURL)
 		return err
@@ -86,17 +113,28 @@ func (a *API) httpGet(ctx context.Context, urlSuffix string, query url.Values, j
 		return temporal.NewNonRetryableApplicationError(msg, fmt.Sprintf("%T", err), err, apiURL)
 	}
 
-	l.Info("successful HTTP GET request", "link_id", a.thrippy.LinkID, "url", apiURL)
+	l.Info("successful HTTP GET request", "link_id", linkID, "url", apiURL)
 	return nil
 }
 
 func (a *API) httpPost(ctx context.Context, urlSuffix string, jsonBody, jsonResp any) error {
-	l, apiURL, botToken, err := a.httpRequestPrep(ctx, urlSuffix)
+	l, linkID, apiURL, auth, err := a.httpRequestPrep(ctx, urlSuffix)
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.HTTPRequest(ctx, http.MethodPost, apiURL, botToken, jsonBody)
+	if err := a.limiter.wait(ctx, linkID, urlSuffix, postMessageChannel(jsonBody)); err != nil {
+		return err
+	}
+
+	jsonBody, err = stripCallOptions(jsonBody)
+	if err != nil {
+		msg := "failed to encode HTTP request's JSON body"
+		l.Error(msg, "error", err.Error(), "url", apiURL)
+		return temporal.NewNonRetryableApplicationError(msg, fmt.Sprintf("%T", err), err, apiURL)
+	}
+
+	resp, err := a.client.AuthenticatedRequest(a.requestContext(ctx, urlSuffix), http.MethodPost, apiURL, auth, jsonBody)
 	if err != nil {
 		l.Error("HTTP POST request error", "error", err.Error(), "url", apiURL)
 		return err
@@ -109,6 +147,42 @@ func (a *API) httpPost(ctx context.Context, urlSuffix string, jsonBody, jsonResp
 		return temporal.NewNonRetryableApplicationError(msg, fmt.Sprintf("%T", err), err, apiURL)
 	}
 
-	l.Info("successful HTTP POST request", "link_id", a.thrippy.LinkID, "url", apiURL)
+	l.Info("successful HTTP POST request", "link_id", linkID, "url", apiURL)
+	return nil
+}
+
+// httpUpload sends the content of a file to a Slack upload URL (which is not a Slack API
+// method), without a Slack token. This is part of the file upload flow in Slack:
+// https://docs.slack.dev/messaging/working-with-files#upload
+//
+// Upload URLs are pre-authorized, and may come from workflows (see
+// [API.FilesUploadToURLActivity]), so they never get the link's credentials.
+func (a *API) httpUpload(ctx context.Context, uploadURL string, content []byte) error {
+	l := logger.FromContext(ctx)
+
+	u, err := url.Parse(uploadURL)
+	if err != nil || u.Scheme != "https" {
+		msg := "invalid Slack file upload URL"
+		l.Error(msg, "url", uploadURL)
+		return temporal.NewNonRetryableApplicationError(msg, "error", err, uploadURL)
+	}
+
+	body := client.RawBody{ContentType: "application/octet-stream", Data: content}
+	ctx = client.WithRequestTimeout(ctx, a.client.SlowTimeout())
+	if _, err := a.client.AuthenticatedRequest(ctx, http.MethodPost, uploadURL, nil, body); err != nil {
+		l.Error("HTTP POST request error", "error", err.Error(), "url", uploadURL)
+		return err
+	}
+
+	l.Info("successful HTTP POST request", "link_id", a.link(ctx).LinkID, "url", uploadURL)
 	return nil
 }
+
+// requestContext returns a copy of the context with a longer
+// HTTP request timeout, if the Slack API method is slow.
+func (a *API) requestContext(ctx context.Context, urlSuffix string) context.Context {
+	if slowMethods[urlSuffix] {
+		return client.WithRequestTimeout(ctx, a.client.SlowTimeout())
+	}
+	return ctx
+}
@@ -0,0 +1,41 @@
+package slack
+
+import (
+	"context"
+)
+
+const (
+	AuthTestName = "slack.auth.test"
+)
+
+// https://docs.slack.dev/reference/methods/auth.test
+type AuthTestRequest struct {
+	CallOptions
+}
+
+// https://docs.slack.dev/reference/methods/auth.test
+type AuthTestResponse struct {
+	slackResponse
+
+	URL                 string `json:"url,omitempty"`
+	Team                string `json:"team,omitempty"`
+	User                string `json:"user,omitempty"`
+	TeamID              string `json:"team_id,omitempty"`
+	UserID              string `json:"user_id,omitempty"`
+	BotID               string `json:"bot_id,omitempty"`
+	AppID               string `json:"app_id,omitempty"`
+	EnterpriseID        string `json:"enterprise_id,omitempty"`
+	IsEnterpriseInstall bool   `json:"is_enterprise_install,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/auth.test
+func (a *API) AuthTestActivity(ctx context.Context, req *AuthTestRequest) (*AuthTestResponse, error) {
+	resp := new(AuthTestResponse)
+	if err := a.httpPost(ctx, AuthTestName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
@@ -0,0 +1,144 @@
+// Package blocks provides typed Slack [Block Kit] blocks, block elements and
+// composition objects, which encode as the exact JSON that Slack expects, and
+// can validate Slack's documented limits before sending them.
+//
+// To use them in Ovid's Slack activities, convert them into the "blocks" field
+// of request structs with [Message] (for messages) or [View] (for modals and
+// App Home tabs). For example:
+//
+//	req := &slack.ChatPostMessageRequest{Channel: "C123"}
+//	req.Blocks, err = blocks.Message(
+//		&blocks.Section{Text: blocks.Markdown("Approve the *deployment*?")},
+//		&blocks.Actions{Elements: []blocks.Element{
+//			&blocks.Button{ActionID: "approve", Text: blocks.PlainText("Approve"), Style: blocks.StylePrimary},
+//			&blocks.Button{ActionID: "deny", Text: blocks.PlainText("Deny"), Style: blocks.StyleDanger},
+//		}},
+//	)
+//
+// [Block Kit]: https://docs.slack.dev/block-kit
+package blocks
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+const (
+	MaxMessageBlocks = 50
+	MaxViewBlocks    = 100
+)
+
+// Block is a top-level Slack layout block.
+//
+// https://docs.slack.dev/reference/block-kit/blocks
+type Block interface {
+	BlockType() string
+	Validate() error
+}
+
+// Element is an interactive or non-interactive component
+// that can be used inside [Section], [Actions] and [Input] blocks.
+//
+// https://docs.slack.dev/reference/block-kit/block-elements
+type Element interface {
+	ElementType() string
+	Validate() error
+}
+
+// ContextElement is an element that can be used inside [Context] blocks:
+// either a [Text] composition object or an [Image] element.
+type ContextElement interface {
+	contextElement()
+	Validate() error
+}
+
+// Message validates up to [MaxMessageBlocks] blocks, and converts them into the
+// "blocks" field of Ovid's Slack chat request structs, e.g. [slack.ChatPostMessageRequest].
+//
+// [slack.ChatPostMessageRequest]: https://pkg.go.dev/github.com/tzrikka/ovid/pkg/slack#ChatPostMessageRequest
+func Message(bs ...Block) ([]map[string]any, error) {
+	return toMaps(MaxMessageBlocks, bs)
+}
+
+// View validates up to [MaxViewBlocks] blocks, and converts them
+// into the "blocks" field of Slack modal and App Home views.
+func View(bs ...Block) ([]map[string]any, error) {
+	return toMaps(MaxViewBlocks, bs)
+}
+
+// Validate checks that the number of blocks doesn't exceed the given limit
+// (e.g. [MaxMessageBlocks]), that each of the blocks is valid on its own,
+// and that their block IDs (if specified) are unique.
+func Validate(limit int, bs ...Block) error {
+	if len(bs) > limit {
+		return fmt.Errorf("too many blocks (%d > %d)", len(bs), limit)
+	}
+
+	ids := map[string]bool{}
+	for i, b := range bs {
+		if isNil(b) {
+			return fmt.Errorf("block %d: missing", i)
+		}
+		if err := b.Validate(); err != nil {
+			return fmt.Errorf("block %d (%s): %w", i, b.BlockType(), err)
+		}
+
+		id := stringField(b, "block_id")
+		if id != "" && ids[id] {
+			return fmt.Errorf("block %d (%s): duplicate \"block_id\" %q", i, b.BlockType(), id)
+		}
+		ids[id] = true
+	}
+
+	return nil
+}
+
+// isNil reports whether v is nil, or a typed nil pointer (e.g. a nil
+// *[Section] in a [Block]), whose methods would otherwise panic.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func, reflect.Chan:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
+
+func toMaps(limit int, bs []Block) ([]map[string]any, error) {
+	if err := Validate(limit, bs...); err != nil {
+		return nil, err
+	}
+
+	j, err := json.Marshal(bs)
+	if err != nil {
+		return nil, err
+	}
+
+	var ms []map[string]any
+	if err := json.Unmarshal(j, &ms); err != nil {
+		return nil, err
+	}
+
+	return ms, nil
+}
+
+// marshalWithType encodes v (a struct without custom JSON methods)
+// as a JSON object, with an additional "type" field at the beginning.
+func marshalWithType(typ string, v any) ([]byte, error) {
+	j, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	b := fmt.Appendf(nil, `{"type":%q`, typ)
+	if len(j) > 2 {
+		b = append(b, ',')
+	}
+	return append(b, j[1:]...), nil
+}
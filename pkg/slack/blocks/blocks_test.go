@@ -0,0 +1,188 @@
+package blocks
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMessage(t *testing.T) {
+	got, err := Message(
+		&Header{Text: PlainText("Deployment")},
+		&Section{
+			Text:      Markdown("Approve the *deployment*?"),
+			Accessory: &Image{ImageURL: "https://example.com/a.png", AltText: "logo"},
+		},
+		&Divider{},
+		&Actions{BlockID: "decision", Elements: []Element{
+			&Button{ActionID: "approve", Text: PlainText("Approve"), Style: StylePrimary, Value: "yes"},
+			&Button{ActionID: "deny", Text: PlainText("Deny"), Style: StyleDanger, Value: "no"},
+		}},
+		&Context{Elements: []ContextElement{Markdown("Requested by <@U123>")}},
+	)
+	if err != nil {
+		t.Fatalf("Message() error = %v", err)
+	}
+
+	want := `[
+		{"type": "header", "text": {"type": "plain_text", "text": "Deployment", "emoji": true}},
+		{"type": "section", "text": {"type": "mrkdwn", "text": "Approve the *deployment*?"},
+		 "accessory": {"type": "image", "image_url": "https://example.com/a.png", "alt_text": "logo"}},
+		{"type": "divider"},
+		{"type": "actions", "block_id": "decision", "elements": [
+			{"type": "button", "action_id": "approve", "text": {"type": "plain_text", "text": "Approve", "emoji": true},
+			 "style": "primary", "value": "yes"},
+			{"type": "button", "action_id": "deny", "text": {"type": "plain_text", "text": "Deny", "emoji": true},
+			 "style": "danger", "value": "no"}
+		]},
+		{"type": "context", "elements": [{"type": "mrkdwn", "text": "Requested by <@U123>"}]}
+	]`
+	var wantMaps []map[string]any
+	if err := json.Unmarshal([]byte(want), &wantMaps); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, wantMaps) {
+		j, _ := json.Marshal(got)
+		t.Errorf("Message() = %s", j)
+	}
+}
+
+func TestMarshalTypeFirst(t *testing.T) {
+	j, err := json.Marshal(&Divider{BlockID: "id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"type":"divider","block_id":"id"}`; string(j) != want {
+		t.Errorf("json.Marshal() = %s, want %s", j, want)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tooManyBlocks := make([]Block, MaxMessageBlocks+1)
+	for i := range tooManyBlocks {
+		tooManyBlocks[i] = &Divider{}
+	}
+
+	tests := []struct {
+		name    string
+		blocks  []Block
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			blocks: []Block{&Divider{}, &Section{Fields: []*Text{PlainText("a"), Markdown("b")}}},
+		},
+		{
+			name:    "too_many_blocks",
+			blocks:  tooManyBlocks,
+			wantErr: "too many blocks (51 > 50)",
+		},
+		{
+			name:    "duplicate_block_ids",
+			blocks:  []Block{&Divider{BlockID: "a"}, &Divider{BlockID: "a"}},
+			wantErr: `block 1 (divider): duplicate "block_id" "a"`,
+		},
+		{
+			name:    "long_block_id",
+			blocks:  []Block{&Divider{BlockID: strings.Repeat("a", 256)}},
+			wantErr: `block 0 (divider): "block_id" is too long (256 > 255 characters)`,
+		},
+		{
+			name:    "header_markdown",
+			blocks:  []Block{&Header{Text: Markdown("title")}},
+			wantErr: `block 0 (header): "text" must be "plain_text", not "mrkdwn"`,
+		},
+		{
+			name:    "long_section_text",
+			blocks:  []Block{&Section{Text: Markdown(strings.Repeat("ä", 3001))}},
+			wantErr: `block 0 (section): "text" is too long (3001 > 3000 characters)`,
+		},
+		{
+			name:    "empty_section",
+			blocks:  []Block{&Section{}},
+			wantErr: `block 0 (section): missing both "text" and "fields"`,
+		},
+		{
+			name: "duplicate_action_ids",
+			blocks: []Block{&Actions{Elements: []Element{
+				&Button{ActionID: "a", Text: PlainText("1")},
+				&Button{ActionID: "a", Text: PlainText("2")},
+			}}},
+			wantErr: `block 0 (actions): "elements" 1 (button): duplicate "action_id" "a"`,
+		},
+		{
+			name: "long_action_id",
+			blocks: []Block{&Actions{Elements: []Element{
+				&Button{ActionID: strings.Repeat("a", 256), Text: PlainText("1")},
+			}}},
+			wantErr: `block 0 (actions): "elements" 0 (button): "action_id" is too long (256 > 255 characters)`,
+		},
+		{
+			name: "invalid_button_style",
+			blocks: []Block{&Actions{Elements: []Element{
+				&Button{Text: PlainText("1"), Style: "bold"},
+			}}},
+			wantErr: `block 0 (actions): "elements" 0 (button): invalid style "bold"`,
+		},
+		{
+			name: "select_options_and_groups",
+			blocks: []Block{&Input{Label: PlainText("l"), Element: &StaticSelect{
+				Options:      []*Option{{Text: PlainText("o"), Value: "v"}},
+				OptionGroups: []*OptionGroup{{Label: PlainText("g"), Options: []*Option{{Text: PlainText("o"), Value: "v"}}}},
+			}}},
+			wantErr: `block 0 (input): "element" (static_select): "options" and "option_groups" are mutually exclusive`,
+		},
+		{
+			name:    "input_without_element",
+			blocks:  []Block{&Input{Label: PlainText("l")}},
+			wantErr: `block 0 (input): missing "element"`,
+		},
+		{
+			name:    "empty_context",
+			blocks:  []Block{&Context{}},
+			wantErr: `block 0 (context): "elements" must have 1-10 items, not 0`,
+		},
+		{
+			name:    "nil_block",
+			blocks:  []Block{&Divider{}, (*Section)(nil)},
+			wantErr: `block 1: missing`,
+		},
+		{
+			name:    "nil_element",
+			blocks:  []Block{&Actions{Elements: []Element{(*Button)(nil)}}},
+			wantErr: `block 0 (actions): "elements" 0: missing`,
+		},
+		{
+			name:    "nil_context_element",
+			blocks:  []Block{&Context{Elements: []ContextElement{(*Text)(nil)}}},
+			wantErr: `block 0 (context): "elements" 0: missing`,
+		},
+		{
+			name:    "nil_input_element",
+			blocks:  []Block{&Input{Label: PlainText("l"), Element: (*PlainTextInput)(nil)}},
+			wantErr: `block 0 (input): missing "element"`,
+		},
+		{
+			name:    "nil_accessory",
+			blocks:  []Block{&Section{Text: PlainText("t"), Accessory: (*Button)(nil)}},
+			wantErr: `block 0 (section): "accessory": missing`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(MaxMessageBlocks, tt.blocks...)
+			if err == nil {
+				if tt.wantErr != "" {
+					t.Errorf("Validate() error = nil, want %q", tt.wantErr)
+				}
+				return
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("Validate() error = %q, want %q", err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
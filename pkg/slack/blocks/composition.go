@@ -0,0 +1,194 @@
+package blocks
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+const (
+	TypePlainText = "plain_text"
+	TypeMarkdown  = "mrkdwn"
+)
+
+// Text is a Slack text composition object.
+//
+// https://docs.slack.dev/reference/block-kit/composition-objects/text-object
+type Text struct {
+	Type     string `json:"type"`
+	Text     string `json:"text"`
+	Emoji    bool   `json:"emoji,omitempty"`    // Only for "plain_text".
+	Verbatim bool   `json:"verbatim,omitempty"` // Only for "mrkdwn".
+}
+
+// PlainText returns a "plain_text" [Text] object, with emojis enabled.
+func PlainText(s string) *Text {
+	return &Text{Type: TypePlainText, Text: s, Emoji: true}
+}
+
+// Markdown returns a "mrkdwn" [Text] object.
+func Markdown(s string) *Text {
+	return &Text{Type: TypeMarkdown, Text: s}
+}
+
+func (t *Text) contextElement() {}
+
+func (t *Text) Validate() error {
+	switch t.Type {
+	case TypePlainText:
+		if t.Verbatim {
+			return errors.New(`"verbatim" is not allowed in "plain_text"`)
+		}
+	case TypeMarkdown:
+		if t.Emoji {
+			return errors.New(`"emoji" is not allowed in "mrkdwn"`)
+		}
+	default:
+		return fmt.Errorf("invalid text type %q", t.Type)
+	}
+
+	if t.Text == "" {
+		return errors.New("empty text")
+	}
+
+	return nil
+}
+
+// Option is a Slack option object, used in select menus,
+// overflow menus, checkboxes and radio buttons.
+//
+// https://docs.slack.dev/reference/block-kit/composition-objects/option-object
+type Option struct {
+	Text        *Text  `json:"text"`
+	Value       string `json:"value"`
+	Description *Text  `json:"description,omitempty"`
+	URL         string `json:"url,omitempty"` // Only in overflow menus.
+}
+
+func (o *Option) Validate() error {
+	if err := validateText("text", o.Text, true, 75, ""); err != nil {
+		return err
+	}
+	if err := validateString("value", o.Value, true, 150); err != nil {
+		return err
+	}
+	if err := validateText("description", o.Description, false, 75, ""); err != nil {
+		return err
+	}
+	return validateString("url", o.URL, false, 3000)
+}
+
+// OptionGroup is a Slack option group object, used in select menus.
+//
+// https://docs.slack.dev/reference/block-kit/composition-objects/option-group-object
+type OptionGroup struct {
+	Label   *Text     `json:"label"`
+	Options []*Option `json:"options"`
+}
+
+func (g *OptionGroup) Validate() error {
+	if err := validateText("label", g.Label, true, 75, TypePlainText); err != nil {
+		return err
+	}
+	return validateOptions("options", g.Options, 1, 100)
+}
+
+// Confirm is a Slack confirmation dialog object.
+//
+// https://docs.slack.dev/reference/block-kit/composition-objects/confirmation-dialog-object
+type Confirm struct {
+	Title   *Text  `json:"title"`
+	Text    *Text  `json:"text"`
+	Confirm *Text  `json:"confirm"`
+	Deny    *Text  `json:"deny"`
+	Style   string `json:"style,omitempty"`
+}
+
+func (c *Confirm) Validate() error {
+	if err := validateText("title", c.Title, true, 100, TypePlainText); err != nil {
+		return err
+	}
+	if err := validateText("text", c.Text, true, 300, ""); err != nil {
+		return err
+	}
+	if err := validateText("confirm", c.Confirm, true, 30, TypePlainText); err != nil {
+		return err
+	}
+	if err := validateText("deny", c.Deny, true, 30, TypePlainText); err != nil {
+		return err
+	}
+	return validateStyle(c.Style)
+}
+
+const (
+	StylePrimary = "primary"
+	StyleDanger  = "danger"
+)
+
+func validateStyle(s string) error {
+	switch s {
+	case "", StylePrimary, StyleDanger:
+		return nil
+	default:
+		return fmt.Errorf("invalid style %q", s)
+	}
+}
+
+// validateString checks whether a required string is missing, and
+// whether a string exceeds a maximum length (in characters).
+func validateString(name, s string, required bool, maxLen int) error {
+	if s == "" {
+		if required {
+			return fmt.Errorf("missing %q", name)
+		}
+		return nil
+	}
+
+	if n := utf8.RuneCountInString(s); n > maxLen {
+		return fmt.Errorf("%q is too long (%d > %d characters)", name, n, maxLen)
+	}
+
+	return nil
+}
+
+// validateText checks whether a required [Text] object is missing, whether it's
+// valid on its own, whether its type is the only allowed one (if typ isn't empty),
+// and whether its text exceeds a maximum length (in characters).
+func validateText(name string, t *Text, required bool, maxLen int, typ string) error {
+	if t == nil {
+		if required {
+			return fmt.Errorf("missing %q", name)
+		}
+		return nil
+	}
+
+	if err := t.Validate(); err != nil {
+		return fmt.Errorf("%q: %w", name, err)
+	}
+	if typ != "" && t.Type != typ {
+		return fmt.Errorf("%q must be %q, not %q", name, typ, t.Type)
+	}
+	if n := utf8.RuneCountInString(t.Text); n > maxLen {
+		return fmt.Errorf("%q is too long (%d > %d characters)", name, n, maxLen)
+	}
+
+	return nil
+}
+
+// validateOptions checks the number of options, and whether each of them is valid.
+func validateOptions(name string, os []*Option, minLen, maxLen int) error {
+	if len(os) < minLen || len(os) > maxLen {
+		return fmt.Errorf("%q must have %d-%d items, not %d", name, minLen, maxLen, len(os))
+	}
+
+	for i, o := range os {
+		if o == nil {
+			return fmt.Errorf("%q %d: missing", name, i)
+		}
+		if err := o.Validate(); err != nil {
+			return fmt.Errorf("%q %d: %w", name, i, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,379 @@
+package blocks
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Button is a Slack button element.
+//
+// https://docs.slack.dev/reference/block-kit/block-elements/button-element
+type Button struct {
+	ActionID           string   `json:"action_id,omitempty"`
+	Text               *Text    `json:"text"`
+	URL                string   `json:"url,omitempty"`
+	Value              string   `json:"value,omitempty"`
+	Style              string   `json:"style,omitempty"`
+	Confirm            *Confirm `json:"confirm,omitempty"`
+	AccessibilityLabel string   `json:"accessibility_label,omitempty"`
+}
+
+func (e *Button) ElementType() string {
+	return "button"
+}
+
+func (e *Button) Validate() error {
+	if err := validateString("action_id", e.ActionID, false, 255); err != nil {
+		return err
+	}
+	if err := validateText("text", e.Text, true, 75, TypePlainText); err != nil {
+		return err
+	}
+	if err := validateString("url", e.URL, false, 3000); err != nil {
+		return err
+	}
+	if err := validateString("value", e.Value, false, 2000); err != nil {
+		return err
+	}
+	if err := validateStyle(e.Style); err != nil {
+		return err
+	}
+	if err := validateConfirm(e.Confirm); err != nil {
+		return err
+	}
+	return validateString("accessibility_label", e.AccessibilityLabel, false, 75)
+}
+
+func (e *Button) MarshalJSON() ([]byte, error) {
+	type button Button
+	return marshalWithType(e.ElementType(), (*button)(e))
+}
+
+// StaticSelect is a Slack select menu element with static options.
+// It must have either options or option groups, but not both.
+//
+// https://docs.slack.dev/reference/block-kit/block-elements/select-menu-element#static_select
+type StaticSelect struct {
+	ActionID      string         `json:"action_id,omitempty"`
+	Placeholder   *Text          `json:"placeholder,omitempty"`
+	Options       []*Option      `json:"options,omitempty"`
+	OptionGroups  []*OptionGroup `json:"option_groups,omitempty"`
+	InitialOption *Option        `json:"initial_option,omitempty"`
+	Confirm       *Confirm       `json:"confirm,omitempty"`
+	FocusOnLoad   bool           `json:"focus_on_load,omitempty"`
+}
+
+func (e *StaticSelect) ElementType() string {
+	return "static_select"
+}
+
+func (e *StaticSelect) Validate() error {
+	if err := validateSelect(e.ActionID, e.Placeholder, e.Confirm); err != nil {
+		return err
+	}
+
+	switch {
+	case len(e.Options) > 0 && len(e.OptionGroups) > 0:
+		return errors.New(`"options" and "option_groups" are mutually exclusive`)
+	case len(e.OptionGroups) > 0:
+		if len(e.OptionGroups) > 100 {
+			return fmt.Errorf(`"option_groups" must have 1-100 items, not %d`, len(e.OptionGroups))
+		}
+		for i, g := range e.OptionGroups {
+			if g == nil {
+				return fmt.Errorf(`"option_groups" %d: missing`, i)
+			}
+			if err := g.Validate(); err != nil {
+				return fmt.Errorf(`"option_groups" %d: %w`, i, err)
+			}
+		}
+	default:
+		if err := validateOptions("options", e.Options, 1, 100); err != nil {
+			return err
+		}
+	}
+
+	if e.InitialOption != nil {
+		if err := e.InitialOption.Validate(); err != nil {
+			return fmt.Errorf(`"initial_option": %w`, err)
+		}
+	}
+
+	return nil
+}
+
+func (e *StaticSelect) MarshalJSON() ([]byte, error) {
+	type staticSelect StaticSelect
+	return marshalWithType(e.ElementType(), (*staticSelect)(e))
+}
+
+// UsersSelect is a Slack select menu element with user options.
+//
+// https://docs.slack.dev/reference/block-kit/block-elements/select-menu-element#users_select
+type UsersSelect struct {
+	ActionID    string   `json:"action_id,omitempty"`
+	Placeholder *Text    `json:"placeholder,omitempty"`
+	InitialUser string   `json:"initial_user,omitempty"`
+	Confirm     *Confirm `json:"confirm,omitempty"`
+	FocusOnLoad bool     `json:"focus_on_load,omitempty"`
+}
+
+func (e *UsersSelect) ElementType() string {
+	return "users_select"
+}
+
+func (e *UsersSelect) Validate() error {
+	return validateSelect(e.ActionID, e.Placeholder, e.Confirm)
+}
+
+func (e *UsersSelect) MarshalJSON() ([]byte, error) {
+	type usersSelect UsersSelect
+	return marshalWithType(e.ElementType(), (*usersSelect)(e))
+}
+
+// ConversationsSelect is a Slack select menu element with conversation options.
+//
+// https://docs.slack.dev/reference/block-kit/block-elements/select-menu-element#conversations_select
+type ConversationsSelect struct {
+	ActionID                     string         `json:"action_id,omitempty"`
+	Placeholder                  *Text          `json:"placeholder,omitempty"`
+	InitialConversation          string         `json:"initial_conversation,omitempty"`
+	DefaultToCurrentConversation bool           `json:"default_to_current_conversation,omitempty"`
+	Filter                       map[string]any `json:"filter,omitempty"`
+	ResponseURLEnabled           bool           `json:"response_url_enabled,omitempty"`
+	Confirm                      *Confirm       `json:"confirm,omitempty"`
+	FocusOnLoad                  bool           `json:"focus_on_load,omitempty"`
+}
+
+func (e *ConversationsSelect) ElementType() string {
+	return "conversations_select"
+}
+
+func (e *ConversationsSelect) Validate() error {
+	return validateSelect(e.ActionID, e.Placeholder, e.Confirm)
+}
+
+func (e *ConversationsSelect) MarshalJSON() ([]byte, error) {
+	type conversationsSelect ConversationsSelect
+	return marshalWithType(e.ElementType(), (*conversationsSelect)(e))
+}
+
+// ChannelsSelect is a Slack select menu element with public channel options.
+//
+// https://docs.slack.dev/reference/block-kit/block-elements/select-menu-element#channels_select
+type ChannelsSelect struct {
+	ActionID           string   `json:"action_id,omitempty"`
+	Placeholder        *Text    `json:"placeholder,omitempty"`
+	InitialChannel     string   `json:"initial_channel,omitempty"`
+	ResponseURLEnabled bool     `json:"response_url_enabled,omitempty"`
+	Confirm            *Confirm `json:"confirm,omitempty"`
+	FocusOnLoad        bool     `json:"focus_on_load,omitempty"`
+}
+
+func (e *ChannelsSelect) ElementType() string {
+	return "channels_select"
+}
+
+func (e *ChannelsSelect) Validate() error {
+	return validateSelect(e.ActionID, e.Placeholder, e.Confirm)
+}
+
+func (e *ChannelsSelect) MarshalJSON() ([]byte, error) {
+	type channelsSelect ChannelsSelect
+	return marshalWithType(e.ElementType(), (*channelsSelect)(e))
+}
+
+// Overflow is a Slack overflow menu element.
+//
+// https://docs.slack.dev/reference/block-kit/block-elements/overflow-menu-element
+type Overflow struct {
+	ActionID string    `json:"action_id,omitempty"`
+	Options  []*Option `json:"options"`
+	Confirm  *Confirm  `json:"confirm,omitempty"`
+}
+
+func (e *Overflow) ElementType() string {
+	return "overflow"
+}
+
+func (e *Overflow) Validate() error {
+	if err := validateString("action_id", e.ActionID, false, 255); err != nil {
+		return err
+	}
+	if err := validateOptions("options", e.Options, 1, 5); err != nil {
+		return err
+	}
+	return validateConfirm(e.Confirm)
+}
+
+func (e *Overflow) MarshalJSON() ([]byte, error) {
+	type overflow Overflow
+	return marshalWithType(e.ElementType(), (*overflow)(e))
+}
+
+// DatePicker is a Slack date picker element.
+//
+// https://docs.slack.dev/reference/block-kit/block-elements/date-picker-element
+type DatePicker struct {
+	ActionID    string   `json:"action_id,omitempty"`
+	InitialDate string   `json:"initial_date,omitempty"` // YYYY-MM-DD.
+	Placeholder *Text    `json:"placeholder,omitempty"`
+	Confirm     *Confirm `json:"confirm,omitempty"`
+	FocusOnLoad bool     `json:"focus_on_load,omitempty"`
+}
+
+func (e *DatePicker) ElementType() string {
+	return "datepicker"
+}
+
+func (e *DatePicker) Validate() error {
+	return validateSelect(e.ActionID, e.Placeholder, e.Confirm)
+}
+
+func (e *DatePicker) MarshalJSON() ([]byte, error) {
+	type datePicker DatePicker
+	return marshalWithType(e.ElementType(), (*datePicker)(e))
+}
+
+// PlainTextInput is a Slack plain-text input element.
+//
+// https://docs.slack.dev/reference/block-kit/block-elements/plain-text-input-element
+type PlainTextInput struct {
+	ActionID     string `json:"action_id,omitempty"`
+	InitialValue string `json:"initial_value,omitempty"`
+	Multiline    bool   `json:"multiline,omitempty"`
+	MinLength    int    `json:"min_length,omitempty"`
+	MaxLength    int    `json:"max_length,omitempty"`
+	Placeholder  *Text  `json:"placeholder,omitempty"`
+	FocusOnLoad  bool   `json:"focus_on_load,omitempty"`
+}
+
+func (e *PlainTextInput) ElementType() string {
+	return "plain_text_input"
+}
+
+func (e *PlainTextInput) Validate() error {
+	if err := validateString("action_id", e.ActionID, false, 255); err != nil {
+		return err
+	}
+	if e.MinLength < 0 || e.MinLength > 3000 {
+		return fmt.Errorf(`"min_length" must be 0-3000, not %d`, e.MinLength)
+	}
+	if e.MaxLength != 0 && e.MaxLength < e.MinLength {
+		return fmt.Errorf(`"max_length" (%d) is less than "min_length" (%d)`, e.MaxLength, e.MinLength)
+	}
+	return validateText("placeholder", e.Placeholder, false, 150, TypePlainText)
+}
+
+func (e *PlainTextInput) MarshalJSON() ([]byte, error) {
+	type plainTextInput PlainTextInput
+	return marshalWithType(e.ElementType(), (*plainTextInput)(e))
+}
+
+// Checkboxes is a Slack checkbox group element.
+//
+// https://docs.slack.dev/reference/block-kit/block-elements/checkboxes-element
+type Checkboxes struct {
+	ActionID       string    `json:"action_id,omitempty"`
+	Options        []*Option `json:"options"`
+	InitialOptions []*Option `json:"initial_options,omitempty"`
+	Confirm        *Confirm  `json:"confirm,omitempty"`
+	FocusOnLoad    bool      `json:"focus_on_load,omitempty"`
+}
+
+func (e *Checkboxes) ElementType() string {
+	return "checkboxes"
+}
+
+func (e *Checkboxes) Validate() error {
+	if err := validateString("action_id", e.ActionID, false, 255); err != nil {
+		return err
+	}
+	if err := validateOptions("options", e.Options, 1, 10); err != nil {
+		return err
+	}
+	return validateConfirm(e.Confirm)
+}
+
+func (e *Checkboxes) MarshalJSON() ([]byte, error) {
+	type checkboxes Checkboxes
+	return marshalWithType(e.ElementType(), (*checkboxes)(e))
+}
+
+// RadioButtons is a Slack radio button group element.
+//
+// https://docs.slack.dev/reference/block-kit/block-elements/radio-button-group-element
+type RadioButtons struct {
+	ActionID      string    `json:"action_id,omitempty"`
+	Options       []*Option `json:"options"`
+	InitialOption *Option   `json:"initial_option,omitempty"`
+	Confirm       *Confirm  `json:"confirm,omitempty"`
+	FocusOnLoad   bool      `json:"focus_on_load,omitempty"`
+}
+
+func (e *RadioButtons) ElementType() string {
+	return "radio_buttons"
+}
+
+func (e *RadioButtons) Validate() error {
+	if err := validateString("action_id", e.ActionID, false, 255); err != nil {
+		return err
+	}
+	if err := validateOptions("options", e.Options, 1, 10); err != nil {
+		return err
+	}
+	return validateConfirm(e.Confirm)
+}
+
+func (e *RadioButtons) MarshalJSON() ([]byte, error) {
+	type radioButtons RadioButtons
+	return marshalWithType(e.ElementType(), (*radioButtons)(e))
+}
+
+// Image is a Slack image element, which can be used
+// inside [Section] and [Context] blocks.
+//
+// https://docs.slack.dev/reference/block-kit/block-elements/image-element
+type Image struct {
+	ImageURL string `json:"image_url"`
+	AltText  string `json:"alt_text"`
+}
+
+func (e *Image) ElementType() string {
+	return "image"
+}
+
+func (e *Image) contextElement() {}
+
+func (e *Image) Validate() error {
+	if err := validateString("image_url", e.ImageURL, true, 3000); err != nil {
+		return err
+	}
+	return validateString("alt_text", e.AltText, true, 2000)
+}
+
+func (e *Image) MarshalJSON() ([]byte, error) {
+	type image Image
+	return marshalWithType(e.ElementType(), (*image)(e))
+}
+
+// validateSelect checks the fields that are common to select menus and similar elements.
+func validateSelect(actionID string, placeholder *Text, confirm *Confirm) error {
+	if err := validateString("action_id", actionID, false, 255); err != nil {
+		return err
+	}
+	if err := validateText("placeholder", placeholder, false, 150, TypePlainText); err != nil {
+		return err
+	}
+	return validateConfirm(confirm)
+}
+
+func validateConfirm(c *Confirm) error {
+	if c == nil {
+		return nil
+	}
+	if err := c.Validate(); err != nil {
+		return fmt.Errorf(`"confirm": %w`, err)
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package blocks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Section is a Slack section block. It must have either text or fields (or both).
+//
+// https://docs.slack.dev/reference/block-kit/blocks/section-block
+type Section struct {
+	BlockID   string  `json:"block_id,omitempty"`
+	Text      *Text   `json:"text,omitempty"`
+	Fields    []*Text `json:"fields,omitempty"`
+	Accessory Element `json:"accessory,omitempty"`
+	Expand    bool    `json:"expand,omitempty"`
+}
+
+func (b *Section) BlockType() string {
+	return "section"
+}
+
+func (b *Section) Validate() error {
+	if err := validateString("block_id", b.BlockID, false, 255); err != nil {
+		return err
+	}
+
+	if b.Text == nil && len(b.Fields) == 0 {
+		return errors.New(`missing both "text" and "fields"`)
+	}
+	if err := validateText("text", b.Text, false, 3000, ""); err != nil {
+		return err
+	}
+
+	if len(b.Fields) > 10 {
+		return fmt.Errorf(`"fields" must have up to 10 items, not %d`, len(b.Fields))
+	}
+	for i, f := range b.Fields {
+		if err := validateText(fmt.Sprintf("fields %d", i), f, true, 2000, ""); err != nil {
+			return err
+		}
+	}
+
+	if b.Accessory != nil {
+		if isNil(b.Accessory) {
+			return errors.New(`"accessory": missing`)
+		}
+		if err := b.Accessory.Validate(); err != nil {
+			return fmt.Errorf(`"accessory" (%s): %w`, b.Accessory.ElementType(), err)
+		}
+	}
+
+	return nil
+}
+
+func (b *Section) MarshalJSON() ([]byte, error) {
+	type section Section
+	return marshalWithType(b.BlockType(), (*section)(b))
+}
+
+// Divider is a Slack divider block.
+//
+// https://docs.slack.dev/reference/block-kit/blocks/divider-block
+type Divider struct {
+	BlockID string `json:"block_id,omitempty"`
+}
+
+func (b *Divider) BlockType() string {
+	return "divider"
+}
+
+func (b *Divider) Validate() error {
+	return validateString("block_id", b.BlockID, false, 255)
+}
+
+func (b *Divider) MarshalJSON() ([]byte, error) {
+	type divider Divider
+	return marshalWithType(b.BlockType(), (*divider)(b))
+}
+
+// Header is a Slack header block.
+//
+// https://docs.slack.dev/reference/block-kit/blocks/header-block
+type Header struct {
+	BlockID string `json:"block_id,omitempty"`
+	Text    *Text  `json:"text"`
+}
+
+func (b *Header) BlockType() string {
+	return "header"
+}
+
+func (b *Header) Validate() error {
+	if err := validateString("block_id", b.BlockID, false, 255); err != nil {
+		return err
+	}
+	return validateText("text", b.Text, true, 150, TypePlainText)
+}
+
+func (b *Header) MarshalJSON() ([]byte, error) {
+	type header Header
+	return marshalWithType(b.BlockType(), (*header)(b))
+}
+
+// Context is a Slack context block.
+//
+// https://docs.slack.dev/reference/block-kit/blocks/context-block
+type Context struct {
+	BlockID  string           `json:"block_id,omitempty"`
+	Elements []ContextElement `json:"elements"`
+}
+
+func (b *Context) BlockType() string {
+	return "context"
+}
+
+func (b *Context) Validate() error {
+	if err := validateString("block_id", b.BlockID, false, 255); err != nil {
+		return err
+	}
+
+	if len(b.Elements) == 0 || len(b.Elements) > 10 {
+		return fmt.Errorf(`"elements" must have 1-10 items, not %d`, len(b.Elements))
+	}
+	for i, e := range b.Elements {
+		if isNil(e) {
+			return fmt.Errorf(`"elements" %d: missing`, i)
+		}
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf(`"elements" %d: %w`, i, err)
+		}
+	}
+
+	return nil
+}
+
+func (b *Context) MarshalJSON() ([]byte, error) {
+	type context Context
+	return marshalWithType(b.BlockType(), (*context)(b))
+}
+
+// Actions is a Slack actions block. The action IDs of
+// its elements must be unique within the block.
+//
+// https://docs.slack.dev/reference/block-kit/blocks/actions-block
+type Actions struct {
+	BlockID  string    `json:"block_id,omitempty"`
+	Elements []Element `json:"elements"`
+}
+
+func (b *Actions) BlockType() string {
+	return "actions"
+}
+
+func (b *Actions) Validate() error {
+	if err := validateString("block_id", b.BlockID, false, 255); err != nil {
+		return err
+	}
+	if len(b.Elements) == 0 || len(b.Elements) > 25 {
+		return fmt.Errorf(`"elements" must have 1-25 items, not %d`, len(b.Elements))
+	}
+
+	ids := map[string]bool{}
+	for i, e := range b.Elements {
+		if isNil(e) {
+			return fmt.Errorf(`"elements" %d: missing`, i)
+		}
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf(`"elements" %d (%s): %w`, i, e.ElementType(), err)
+		}
+
+		id := stringField(e, "action_id")
+		if id != "" && ids[id] {
+			return fmt.Errorf(`"elements" %d (%s): duplicate "action_id" %q`, i, e.ElementType(), id)
+		}
+		ids[id] = true
+	}
+
+	return nil
+}
+
+func (b *Actions) MarshalJSON() ([]byte, error) {
+	type actions Actions
+	return marshalWithType(b.BlockType(), (*actions)(b))
+}
+
+// ImageBlock is a Slack image block.
+//
+// https://docs.slack.dev/reference/block-kit/blocks/image-block
+type ImageBlock struct {
+	BlockID  string `json:"block_id,omitempty"`
+	ImageURL string `json:"image_url"`
+	AltText  string `json:"alt_text"`
+	Title    *Text  `json:"title,omitempty"`
+}
+
+func (b *ImageBlock) BlockType() string {
+	return "image"
+}
+
+func (b *ImageBlock) Validate() error {
+	if err := validateString("block_id", b.BlockID, false, 255); err != nil {
+		return err
+	}
+	if err := validateString("image_url", b.ImageURL, true, 3000); err != nil {
+		return err
+	}
+	if err := validateString("alt_text", b.AltText, true, 2000); err != nil {
+		return err
+	}
+	return validateText("title", b.Title, false, 2000, TypePlainText)
+}
+
+func (b *ImageBlock) MarshalJSON() ([]byte, error) {
+	type imageBlock ImageBlock
+	return marshalWithType(b.BlockType(), (*imageBlock)(b))
+}
+
+// Input is a Slack input block, which is mostly used in modals.
+//
+// https://docs.slack.dev/reference/block-kit/blocks/input-block
+type Input struct {
+	BlockID        string  `json:"block_id,omitempty"`
+	Label          *Text   `json:"label"`
+	Element        Element `json:"element"`
+	DispatchAction bool    `json:"dispatch_action,omitempty"`
+	Hint           *Text   `json:"hint,omitempty"`
+	Optional       bool    `json:"optional,omitempty"`
+}
+
+func (b *Input) BlockType() string {
+	return "input"
+}
+
+func (b *Input) Validate() error {
+	if err := validateString("block_id", b.BlockID, false, 255); err != nil {
+		return err
+	}
+	if err := validateText("label", b.Label, true, 2000, TypePlainText); err != nil {
+		return err
+	}
+
+	if isNil(b.Element) {
+		return errors.New(`missing "element"`)
+	}
+	if err := b.Element.Validate(); err != nil {
+		return fmt.Errorf(`"element" (%s): %w`, b.Element.ElementType(), err)
+	}
+
+	return validateText("hint", b.Hint, false, 2000, TypePlainText)
+}
+
+func (b *Input) MarshalJSON() ([]byte, error) {
+	type input Input
+	return marshalWithType(b.BlockType(), (*input)(b))
+}
+
+// stringField returns the value of a top-level string field in the JSON encoding
+// of v, or an empty string if it's not found. This supports user-defined types.
+func stringField(v any, name string) string {
+	j, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(j, &m); err != nil {
+		return ""
+	}
+
+	s, _ := m[name].(string)
+	return s
+}
@@ -0,0 +1,156 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+)
+
+const (
+	BookmarksAddName    = "slack.bookmarks.add"
+	BookmarksEditName   = "slack.bookmarks.edit"
+	BookmarksListName   = "slack.bookmarks.list"
+	BookmarksRemoveName = "slack.bookmarks.remove"
+)
+
+// Bookmark is a link in the bookmarks bar of a Slack channel.
+//
+// https://docs.slack.dev/reference/methods/bookmarks.list
+type Bookmark struct {
+	ID        string `json:"id"`
+	ChannelID string `json:"channel_id,omitempty"`
+	Title     string `json:"title,omitempty"`
+	Link      string `json:"link,omitempty"`
+	Emoji     string `json:"emoji,omitempty"`
+	IconURL   string `json:"icon_url,omitempty"`
+	Type      string `json:"type,omitempty"`
+	EntityID  string `json:"entity_id,omitempty"`
+	Rank      string `json:"rank,omitempty"`
+
+	DateCreated         int64  `json:"date_created,omitempty"`
+	DateUpdated         int64  `json:"date_updated,omitempty"`
+	LastUpdatedByUserID string `json:"last_updated_by_user_id,omitempty"`
+	LastUpdatedByTeamID string `json:"last_updated_by_team_id,omitempty"`
+	ShortcutID          string `json:"shortcut_id,omitempty"`
+	AppID               string `json:"app_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/bookmarks.add
+type BookmarksAddRequest struct {
+	CallOptions
+
+	ChannelID string `json:"channel_id"`
+	Title     string `json:"title"`
+	Type      string `json:"type"` // Currently only "link".
+
+	AccessLevel string `json:"access_level,omitempty"`
+	Emoji       string `json:"emoji,omitempty"`
+	EntityID    string `json:"entity_id,omitempty"`
+	Link        string `json:"link,omitempty"`
+	ParentID    string `json:"parent_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/bookmarks.add
+type BookmarksAddResponse struct {
+	slackResponse
+
+	Bookmark *Bookmark `json:"bookmark,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/bookmarks.add
+func (a *API) BookmarksAddActivity(ctx context.Context, req *BookmarksAddRequest) (*BookmarksAddResponse, error) {
+	resp := new(BookmarksAddResponse)
+	if err := a.httpPost(ctx, BookmarksAddName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/bookmarks.edit
+type BookmarksEditRequest struct {
+	CallOptions
+
+	BookmarkID string `json:"bookmark_id"`
+	ChannelID  string `json:"channel_id"`
+
+	Emoji string `json:"emoji,omitempty"`
+	Link  string `json:"link,omitempty"`
+	Title string `json:"title,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/bookmarks.edit
+type BookmarksEditResponse struct {
+	slackResponse
+
+	Bookmark *Bookmark `json:"bookmark,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/bookmarks.edit
+func (a *API) BookmarksEditActivity(ctx context.Context, req *BookmarksEditRequest) (*BookmarksEditResponse, error) {
+	resp := new(BookmarksEditResponse)
+	if err := a.httpPost(ctx, BookmarksEditName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/bookmarks.list
+type BookmarksListRequest struct {
+	CallOptions
+
+	ChannelID string `json:"channel_id"`
+}
+
+// https://docs.slack.dev/reference/methods/bookmarks.list
+type BookmarksListResponse struct {
+	slackResponse
+
+	Bookmarks []Bookmark `json:"bookmarks,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/bookmarks.list
+func (a *API) BookmarksListActivity(ctx context.Context, req *BookmarksListRequest) (*BookmarksListResponse, error) {
+	query := url.Values{}
+	query.Set("channel_id", req.ChannelID)
+
+	resp := new(BookmarksListResponse)
+	if err := a.httpGet(ctx, BookmarksListName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/bookmarks.remove
+type BookmarksRemoveRequest struct {
+	CallOptions
+
+	BookmarkID string `json:"bookmark_id"`
+	ChannelID  string `json:"channel_id"`
+
+	QuipSectionID string `json:"quip_section_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/bookmarks.remove
+type BookmarksRemoveResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/bookmarks.remove
+func (a *API) BookmarksRemoveActivity(ctx context.Context, req *BookmarksRemoveRequest) (*BookmarksRemoveResponse, error) {
+	resp := new(BookmarksRemoveResponse)
+	if err := a.httpPost(ctx, BookmarksRemoveName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
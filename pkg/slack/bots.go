@@ -0,0 +1,57 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+)
+
+const (
+	BotsInfoName = "slack.bots.info"
+)
+
+// Bot is a Slack bot user object.
+//
+// https://docs.slack.dev/reference/methods/bots.info
+type Bot struct {
+	ID      string            `json:"id"`
+	Name    string            `json:"name,omitempty"`
+	Deleted bool              `json:"deleted,omitempty"`
+	Updated int64             `json:"updated,omitempty"`
+	AppID   string            `json:"app_id,omitempty"`
+	UserID  string            `json:"user_id,omitempty"`
+	Icons   map[string]string `json:"icons,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/bots.info
+type BotsInfoRequest struct {
+	CallOptions
+
+	Bot string `json:"bot"`
+
+	TeamID string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/bots.info
+type BotsInfoResponse struct {
+	slackResponse
+
+	Bot *Bot `json:"bot,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/bots.info
+func (a *API) BotsInfoActivity(ctx context.Context, req *BotsInfoRequest) (*BotsInfoResponse, error) {
+	query := url.Values{}
+	query.Set("bot", req.Bot)
+	if req.TeamID != "" {
+		query.Set("team_id", req.TeamID)
+	}
+
+	resp := new(BotsInfoResponse)
+	if err := a.httpGet(ctx, BotsInfoName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
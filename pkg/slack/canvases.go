@@ -0,0 +1,228 @@
+package slack
+
+import (
+	"context"
+)
+
+const (
+	CanvasesAccessDeleteName   = "slack.canvases.access.delete"
+	CanvasesAccessSetName      = "slack.canvases.access.set"
+	CanvasesCreateName         = "slack.canvases.create"
+	CanvasesDeleteName         = "slack.canvases.delete"
+	CanvasesEditName           = "slack.canvases.edit"
+	CanvasesSectionsLookupName = "slack.canvases.sections.lookup"
+)
+
+// DocumentContent is the content of a Slack canvas, or of a section in it.
+// Markdown is currently the only content type that Slack supports, so
+// use [MarkdownContent] to initialize it.
+//
+// https://docs.slack.dev/surfaces/canvases#formatting
+type DocumentContent struct {
+	Type     string `json:"type"`
+	Markdown string `json:"markdown"`
+}
+
+// MarkdownContent returns a [DocumentContent] of type "markdown".
+func MarkdownContent(markdown string) *DocumentContent {
+	return &DocumentContent{Type: "markdown", Markdown: markdown}
+}
+
+// CanvasChange is a single operation in [CanvasesEditRequest].
+// SectionID is required in "insert_after", "insert_before" and
+// "delete" operations, and optional in "replace" operations.
+// DocumentContent is required in all the operations except
+// "delete" and "rename", which requires TitleContent instead.
+//
+// https://docs.slack.dev/reference/methods/canvases.edit
+type CanvasChange struct {
+	Operation string `json:"operation"`
+
+	SectionID       string           `json:"section_id,omitempty"`
+	DocumentContent *DocumentContent `json:"document_content,omitempty"`
+	TitleContent    *DocumentContent `json:"title_content,omitempty"`
+}
+
+// Canvas edit operations.
+const (
+	CanvasInsertAfter   = "insert_after"
+	CanvasInsertBefore  = "insert_before"
+	CanvasInsertAtStart = "insert_at_start"
+	CanvasInsertAtEnd   = "insert_at_end"
+	CanvasReplace       = "replace"
+	CanvasDelete        = "delete"
+	CanvasRename        = "rename"
+)
+
+// CanvasSectionCriteria filters the sections in [CanvasesSectionsLookupRequest].
+// SectionTypes may include "h1", "h2", "h3", and "any_header".
+type CanvasSectionCriteria struct {
+	SectionTypes []string `json:"section_types,omitempty"`
+	ContainsText string   `json:"contains_text,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/canvases.access.delete
+type CanvasesAccessDeleteRequest struct {
+	CallOptions
+
+	CanvasID string `json:"canvas_id"`
+
+	ChannelIDs []string `json:"channel_ids,omitempty"`
+	UserIDs    []string `json:"user_ids,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/canvases.access.delete
+type CanvasesAccessDeleteResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/canvases.access.delete
+func (a *API) CanvasesAccessDeleteActivity(ctx context.Context, req *CanvasesAccessDeleteRequest) (*CanvasesAccessDeleteResponse, error) {
+	resp := new(CanvasesAccessDeleteResponse)
+	if err := a.httpPost(ctx, CanvasesAccessDeleteName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// AccessLevel is "read", "write", or "owner" (only for users).
+//
+// https://docs.slack.dev/reference/methods/canvases.access.set
+type CanvasesAccessSetRequest struct {
+	CallOptions
+
+	AccessLevel string `json:"access_level"`
+	CanvasID    string `json:"canvas_id"`
+
+	ChannelIDs []string `json:"channel_ids,omitempty"`
+	UserIDs    []string `json:"user_ids,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/canvases.access.set
+type CanvasesAccessSetResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/canvases.access.set
+func (a *API) CanvasesAccessSetActivity(ctx context.Context, req *CanvasesAccessSetRequest) (*CanvasesAccessSetResponse, error) {
+	resp := new(CanvasesAccessSetResponse)
+	if err := a.httpPost(ctx, CanvasesAccessSetName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/canvases.create
+type CanvasesCreateRequest struct {
+	CallOptions
+
+	ChannelID       string           `json:"channel_id,omitempty"`
+	DocumentContent *DocumentContent `json:"document_content,omitempty"`
+	Title           string           `json:"title,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/canvases.create
+type CanvasesCreateResponse struct {
+	slackResponse
+
+	CanvasID string `json:"canvas_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/canvases.create
+func (a *API) CanvasesCreateActivity(ctx context.Context, req *CanvasesCreateRequest) (*CanvasesCreateResponse, error) {
+	resp := new(CanvasesCreateResponse)
+	if err := a.httpPost(ctx, CanvasesCreateName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/canvases.delete
+type CanvasesDeleteRequest struct {
+	CallOptions
+
+	CanvasID string `json:"canvas_id"`
+}
+
+// https://docs.slack.dev/reference/methods/canvases.delete
+type CanvasesDeleteResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/canvases.delete
+func (a *API) CanvasesDeleteActivity(ctx context.Context, req *CanvasesDeleteRequest) (*CanvasesDeleteResponse, error) {
+	resp := new(CanvasesDeleteResponse)
+	if err := a.httpPost(ctx, CanvasesDeleteName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/canvases.edit
+type CanvasesEditRequest struct {
+	CallOptions
+
+	CanvasID string         `json:"canvas_id"`
+	Changes  []CanvasChange `json:"changes"`
+}
+
+// https://docs.slack.dev/reference/methods/canvases.edit
+type CanvasesEditResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/canvases.edit
+func (a *API) CanvasesEditActivity(ctx context.Context, req *CanvasesEditRequest) (*CanvasesEditResponse, error) {
+	resp := new(CanvasesEditResponse)
+	if err := a.httpPost(ctx, CanvasesEditName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/canvases.sections.lookup
+type CanvasesSectionsLookupRequest struct {
+	CallOptions
+
+	CanvasID string                `json:"canvas_id"`
+	Criteria CanvasSectionCriteria `json:"criteria"`
+}
+
+// https://docs.slack.dev/reference/methods/canvases.sections.lookup
+type CanvasesSectionsLookupResponse struct {
+	slackResponse
+
+	Sections []CanvasSection `json:"sections,omitempty"`
+}
+
+// CanvasSection identifies a section in a Slack canvas, for [CanvasChange].
+type CanvasSection struct {
+	ID string `json:"id"`
+}
+
+// https://docs.slack.dev/reference/methods/canvases.sections.lookup
+func (a *API) CanvasesSectionsLookupActivity(ctx context.Context, req *CanvasesSectionsLookupRequest) (*CanvasesSectionsLookupResponse, error) {
+	resp := new(CanvasesSectionsLookupResponse)
+	if err := a.httpPost(ctx, CanvasesSectionsLookupName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
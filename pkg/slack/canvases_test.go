@@ -0,0 +1,43 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCanvasesEditRequest(t *testing.T) {
+	req := &CanvasesEditRequest{
+		CanvasID: "F1",
+		Changes: []CanvasChange{
+			{
+				Operation:       CanvasInsertAfter,
+				SectionID:       "temp:C:1",
+				DocumentContent: MarkdownContent("## Timeline"),
+			},
+			{
+				Operation:    CanvasRename,
+				TitleContent: MarkdownContent("Postmortem"),
+			},
+		},
+	}
+
+	got, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	want := `{"canvas_id": "F1", "changes": [
+		{
+			"operation": "insert_after",
+			"section_id": "temp:C:1",
+			"document_content": {"type": "markdown", "markdown": "## Timeline"}
+		},
+		{
+			"operation": "rename",
+			"title_content": {"type": "markdown", "markdown": "Postmortem"}
+		}
+	]}`
+	if !jsonEqual(t, got, []byte(want)) {
+		t.Errorf("json.Marshal() = %s", got)
+	}
+}
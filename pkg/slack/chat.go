@@ -2,20 +2,28 @@ package slack
 
 import (
 	"context"
-	"errors"
 	"net/url"
+	"strconv"
 )
 
 const (
-	ChatDeleteName        = "slack.chat.delete"
-	ChatGetPermalinkName  = "slack.chat.getPermalink"
-	ChatPostEphemeralName = "slack.chat.postEphemeral"
-	ChatPostMessageName   = "slack.chat.postMessage"
-	ChatUpdateName        = "slack.chat.update"
+	ChatDeleteName                   = "slack.chat.delete"
+	ChatDeleteScheduledMessageName   = "slack.chat.deleteScheduledMessage"
+	ChatGetPermalinkName             = "slack.chat.getPermalink"
+	ChatMeMessageName                = "slack.chat.meMessage"
+	ChatPostEphemeralName            = "slack.chat.postEphemeral"
+	ChatPostMessageName              = "slack.chat.postMessage"
+	ChatScheduleMessageName          = "slack.chat.scheduleMessage"
+	ChatScheduledMessagesListName    = "slack.chat.scheduledMessages.list"
+	ChatScheduledMessagesListAllName = "slack.chat.scheduledMessages.list.all"
+	ChatUnfurlName                   = "slack.chat.unfurl"
+	ChatUpdateName                   = "slack.chat.update"
 )
 
 // https://docs.slack.dev/reference/methods/chat.delete
 type ChatDeleteRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 	TS      string `json:"ts"`
 
@@ -37,13 +45,42 @@ func (a *API) ChatDeleteActivity(ctx context.Context, req *ChatDeleteRequest) (*
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/chat.deleteScheduledMessage
+type ChatDeleteScheduledMessageRequest struct {
+	CallOptions
+
+	Channel            string `json:"channel"`
+	ScheduledMessageID string `json:"scheduled_message_id"`
+
+	AsUser bool `json:"as_user,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/chat.deleteScheduledMessage
+type ChatDeleteScheduledMessageResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/chat.deleteScheduledMessage
+func (a *API) ChatDeleteScheduledMessageActivity(ctx context.Context, req *ChatDeleteScheduledMessageRequest) (*ChatDeleteScheduledMessageResponse, error) {
+	resp := new(ChatDeleteScheduledMessageResponse)
+	if err := a.httpPost(ctx, ChatDeleteScheduledMessageName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/chat.getPermalink
 type ChatGetPermalinkRequest struct {
+	CallOptions
+
 	Channel   string `json:"channel"`
 	MessageTS string `json:"message_ts"`
 }
@@ -67,7 +104,35 @@ func (a *API) ChatGetPermalinkActivity(ctx context.Context, req *ChatGetPermalin
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/chat.meMessage
+type ChatMeMessageRequest struct {
+	CallOptions
+
+	Channel string `json:"channel"`
+	Text    string `json:"text"`
+}
+
+// https://docs.slack.dev/reference/methods/chat.meMessage
+type ChatMeMessageResponse struct {
+	slackResponse
+
+	Channel string `json:"channel,omitempty"`
+	TS      string `json:"ts,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/chat.meMessage
+func (a *API) ChatMeMessageActivity(ctx context.Context, req *ChatMeMessageRequest) (*ChatMeMessageResponse, error) {
+	resp := new(ChatMeMessageResponse)
+	if err := a.httpPost(ctx, ChatMeMessageName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
@@ -76,6 +141,8 @@ func (a *API) ChatGetPermalinkActivity(ctx context.Context, req *ChatGetPermalin
 //
 // https://docs.slack.dev/reference/methods/chat.postMessage#channels
 type ChatPostEphemeralRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 	User    string `json:"user"`
 
@@ -105,13 +172,15 @@ func (a *API) ChatPostEphemeralActivity(ctx context.Context, req *ChatPostEpheme
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/chat.postMessage
 type ChatPostMessageRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 
 	Attachments  []map[string]any `json:"attachments,omitempty"`
@@ -134,9 +203,9 @@ type ChatPostMessageRequest struct {
 type ChatPostMessageResponse struct {
 	slackResponse
 
-	Channel string         `json:"channel,omitempty"`
-	TS      string         `json:"ts,omitempty"`
-	Message map[string]any `json:"message,omitempty"`
+	Channel string   `json:"channel,omitempty"`
+	TS      string   `json:"ts,omitempty"`
+	Message *Message `json:"message,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/chat.postMessage
@@ -146,7 +215,183 @@ func (a *API) ChatPostMessageActivity(ctx context.Context, req *ChatPostMessageR
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// ChatScheduleMessageRequest schedules a message to be sent by Slack itself at
+// PostAt (Unix time in seconds), up to 120 days in the future. Unlike a durable
+// timer in a workflow, this doesn't depend on the availability of Temporal workers.
+//
+// https://docs.slack.dev/reference/methods/chat.scheduleMessage
+type ChatScheduleMessageRequest struct {
+	CallOptions
+
+	Channel string `json:"channel"`
+	PostAt  int64  `json:"post_at"`
+
+	Attachments    []map[string]any `json:"attachments,omitempty"`
+	Blocks         []map[string]any `json:"blocks,omitempty"`
+	LinkNames      bool             `json:"link_names,omitempty"`
+	MarkdownText   string           `json:"markdown_text,omitempty"`
+	Metadata       map[string]any   `json:"metadata,omitempty"`
+	Parse          string           `json:"parse,omitempty"`
+	ReplyBroadcast bool             `json:"reply_broadcast,omitempty"`
+	Text           string           `json:"text,omitempty"`
+	ThreadTS       string           `json:"thread_ts,omitempty"`
+	UnfurlLinks    bool             `json:"unfurl_links,omitempty"`
+	UnfurlMedia    bool             `json:"unfurl_media,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/chat.scheduleMessage
+type ChatScheduleMessageResponse struct {
+	slackResponse
+
+	Channel            string   `json:"channel,omitempty"`
+	ScheduledMessageID string   `json:"scheduled_message_id,omitempty"`
+	PostAt             int64    `json:"post_at,omitempty"`
+	Message            *Message `json:"message,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/chat.scheduleMessage
+func (a *API) ChatScheduleMessageActivity(ctx context.Context, req *ChatScheduleMessageRequest) (*ChatScheduleMessageResponse, error) {
+	resp := new(ChatScheduleMessageResponse)
+	if err := a.httpPost(ctx, ChatScheduleMessageName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/chat.scheduledMessages.list
+type ChatScheduledMessagesListRequest struct {
+	CallOptions
+
+	Channel string `json:"channel,omitempty"`
+	Cursor  string `json:"cursor,omitempty"`
+	Latest  string `json:"latest,omitempty"`
+	Limit   int    `json:"limit,omitempty"`
+	Oldest  string `json:"oldest,omitempty"`
+	TeamID  string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/chat.scheduledMessages.list
+type ChatScheduledMessagesListResponse struct {
+	slackResponse
+
+	ScheduledMessages []ScheduledMessage `json:"scheduled_messages,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/chat.scheduledMessages.list
+type ScheduledMessage struct {
+	ID          string `json:"id"`
+	ChannelID   string `json:"channel_id"`
+	PostAt      int64  `json:"post_at"`
+	DateCreated int64  `json:"date_created,omitempty"`
+	Text        string `json:"text,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/chat.scheduledMessages.list
+func (a *API) ChatScheduledMessagesListActivity(ctx context.Context, req *ChatScheduledMessagesListRequest) (*ChatScheduledMessagesListResponse, error) {
+	query := url.Values{}
+	if req.Channel != "" {
+		query.Set("channel", req.Channel)
+	}
+	if req.Cursor != "" {
+		query.Set("cursor", req.Cursor)
+	}
+	if req.Latest != "" {
+		query.Set("latest", req.Latest)
+	}
+	if req.Limit != 0 {
+		query.Set("limit", strconv.Itoa(req.Limit))
+	}
+	if req.Oldest != "" {
+		query.Set("oldest", req.Oldest)
+	}
+	if req.TeamID != "" {
+		query.Set("team_id", req.TeamID)
+	}
+
+	resp := new(ChatScheduledMessagesListResponse)
+	if err := a.httpGet(ctx, ChatScheduledMessagesListName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/chat.scheduledMessages.list
+type ChatScheduledMessagesListAllRequest struct {
+	ChatScheduledMessagesListRequest
+
+	MaxItems int `json:"max_items,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/chat.scheduledMessages.list
+type ChatScheduledMessagesListAllResponse struct {
+	ScheduledMessages []ScheduledMessage `json:"scheduled_messages,omitempty"`
+	NextCursor        string             `json:"next_cursor,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/chat.scheduledMessages.list
+func (a *API) ChatScheduledMessagesListAllActivity(ctx context.Context, req *ChatScheduledMessagesListAllRequest) (*ChatScheduledMessagesListAllResponse, error) {
+	items, next, err := paginate(ctx, req.Cursor, req.MaxItems, func(ctx context.Context, cursor string) ([]ScheduledMessage, string, error) {
+		page := req.ChatScheduledMessagesListRequest
+		page.Cursor = cursor
+		resp, err := a.ChatScheduledMessagesListActivity(ctx, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.ScheduledMessages, resp.nextCursor(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChatScheduledMessagesListAllResponse{ScheduledMessages: items, NextCursor: next}, nil
+}
+
+// ChatUnfurlRequest provides custom unfurls for URLs in a message, typically in
+// response to a "link_shared" event. Unfurls maps each URL to an attachment
+// or a "blocks" object. A message is identified either by Channel and TS,
+// or by Source and UnfurlID (for links in the message composer).
+//
+// https://docs.slack.dev/reference/methods/chat.unfurl
+type ChatUnfurlRequest struct {
+	CallOptions
+
+	Unfurls map[string]map[string]any `json:"unfurls"`
+
+	Channel  string `json:"channel,omitempty"`
+	TS       string `json:"ts,omitempty"`
+	Source   string `json:"source,omitempty"`
+	UnfurlID string `json:"unfurl_id,omitempty"`
+
+	UserAuthBlocks   []map[string]any `json:"user_auth_blocks,omitempty"`
+	UserAuthMessage  string           `json:"user_auth_message,omitempty"`
+	UserAuthRequired bool             `json:"user_auth_required,omitempty"`
+	UserAuthURL      string           `json:"user_auth_url,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/chat.unfurl
+type ChatUnfurlResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/chat.unfurl
+func (a *API) ChatUnfurlActivity(ctx context.Context, req *ChatUnfurlRequest) (*ChatUnfurlResponse, error) {
+	resp := new(ChatUnfurlResponse)
+	if err := a.httpPost(ctx, ChatUnfurlName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
@@ -155,6 +400,8 @@ func (a *API) ChatPostMessageActivity(ctx context.Context, req *ChatPostMessageR
 //
 // https://docs.slack.dev/reference/methods/chat.postMessage#channels
 type ChatUpdateRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 	TS      string `json:"ts"`
 
@@ -173,10 +420,10 @@ type ChatUpdateRequest struct {
 type ChatUpdateResponse struct {
 	slackResponse
 
-	Channel string         `json:"channel,omitempty"`
-	TS      string         `json:"ts,omitempty"`
-	Text    string         `json:"text,omitempty"`
-	Message map[string]any `json:"message,omitempty"`
+	Channel string   `json:"channel,omitempty"`
+	TS      string   `json:"ts,omitempty"`
+	Text    string   `json:"text,omitempty"`
+	Message *Message `json:"message,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/chat.update
@@ -186,7 +433,7 @@ func (a *API) ChatUpdateActivity(ctx context.Context, req *ChatUpdateRequest) (*
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
@@ -2,33 +2,39 @@ package slack
 
 import (
 	"context"
-	"errors"
 	"net/url"
 	"strconv"
 )
 
 const (
-	ConversationsArchiveName    = "slack.conversations.archive"
-	ConversationsCloseName      = "slack.conversations.close"
-	ConversationsCreateName     = "slack.conversations.create"
-	ConversationsHistoryName    = "slack.conversations.history"
-	ConversationsInfoName       = "slack.conversations.info"
-	ConversationsInviteName     = "slack.conversations.invite"
-	ConversationsJoinName       = "slack.conversations.join"
-	ConversationsKickName       = "slack.conversations.kick"
-	ConversationsLeaveName      = "slack.conversations.leave"
-	ConversationsListName       = "slack.conversations.list"
-	ConversationsMembersName    = "slack.conversations.members"
-	ConversationsOpenName       = "slack.conversations.open"
-	ConversationsRenameName     = "slack.conversations.rename"
-	ConversationsRepliesName    = "slack.conversations.replies"
-	ConversationsSetPurposeName = "slack.conversations.setPurpose"
-	ConversationsSetTopicName   = "slack.conversations.setTopic"
-	ConversationsUnarchiveName  = "slack.conversations.unarchive"
+	ConversationsArchiveName        = "slack.conversations.archive"
+	ConversationsCanvasesCreateName = "slack.conversations.canvases.create"
+	ConversationsCloseName          = "slack.conversations.close"
+	ConversationsCreateName         = "slack.conversations.create"
+	ConversationsHistoryName        = "slack.conversations.history"
+	ConversationsHistoryAllName     = "slack.conversations.history.all"
+	ConversationsInfoName           = "slack.conversations.info"
+	ConversationsInviteName         = "slack.conversations.invite"
+	ConversationsJoinName           = "slack.conversations.join"
+	ConversationsKickName           = "slack.conversations.kick"
+	ConversationsLeaveName          = "slack.conversations.leave"
+	ConversationsListName           = "slack.conversations.list"
+	ConversationsListAllName        = "slack.conversations.list.all"
+	ConversationsMembersName        = "slack.conversations.members"
+	ConversationsMembersAllName     = "slack.conversations.members.all"
+	ConversationsOpenName           = "slack.conversations.open"
+	ConversationsRenameName         = "slack.conversations.rename"
+	ConversationsRepliesName        = "slack.conversations.replies"
+	ConversationsRepliesAllName     = "slack.conversations.replies.all"
+	ConversationsSetPurposeName     = "slack.conversations.setPurpose"
+	ConversationsSetTopicName       = "slack.conversations.setTopic"
+	ConversationsUnarchiveName      = "slack.conversations.unarchive"
 )
 
 // https://docs.slack.dev/reference/methods/conversations.archive
 type ConversationsArchiveRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 }
 
@@ -44,13 +50,44 @@ func (a *API) ConversationsArchiveActivity(ctx context.Context, req *Conversatio
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/conversations.canvases.create
+type ConversationsCanvasesCreateRequest struct {
+	CallOptions
+
+	ChannelID string `json:"channel_id"`
+
+	DocumentContent *DocumentContent `json:"document_content,omitempty"`
+	Title           string           `json:"title,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/conversations.canvases.create
+type ConversationsCanvasesCreateResponse struct {
+	slackResponse
+
+	CanvasID string `json:"canvas_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/conversations.canvases.create
+func (a *API) ConversationsCanvasesCreateActivity(ctx context.Context, req *ConversationsCanvasesCreateRequest) (*ConversationsCanvasesCreateResponse, error) {
+	resp := new(ConversationsCanvasesCreateResponse)
+	if err := a.httpPost(ctx, ConversationsCanvasesCreateName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/conversations.close
 type ConversationsCloseRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 }
 
@@ -68,13 +105,15 @@ func (a *API) ConversationsCloseActivity(ctx context.Context, req *Conversations
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/conversations.create
 type ConversationsCreateRequest struct {
+	CallOptions
+
 	Name string `json:"name"`
 
 	IsPrivate bool   `json:"is_private,omitempty"`
@@ -85,7 +124,7 @@ type ConversationsCreateRequest struct {
 type ConversationsCreateResponse struct {
 	slackResponse
 
-	Channel map[string]any `json:"channel,omitempty"`
+	Channel *Channel `json:"channel,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/conversations.create
@@ -95,13 +134,15 @@ func (a *API) ConversationsCreateActivity(ctx context.Context, req *Conversation
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/conversations.history
 type ConversationsHistoryRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 
 	Cursor             string `json:"cursor,omitempty"`
@@ -116,11 +157,11 @@ type ConversationsHistoryRequest struct {
 type ConversationsHistoryResponse struct {
 	slackResponse
 
-	Latest    string           `json:"latest,omitempty"`
-	Messages  []map[string]any `json:"messages,omitempty"`
-	HasMore   bool             `json:"has_more,omitempty"`
-	IsLimited bool             `json:"is_limited,omitempty"` // Undocumented.
-	PinCount  int              `json:"pin_count,omitempty"`
+	Latest    string    `json:"latest,omitempty"`
+	Messages  []Message `json:"messages,omitempty"`
+	HasMore   bool      `json:"has_more,omitempty"`
+	IsLimited bool      `json:"is_limited,omitempty"` // Undocumented.
+	PinCount  int       `json:"pin_count,omitempty"`
 	// Undocumented: "channel_actions_ts" and "channel_actions_count".
 }
 
@@ -152,13 +193,46 @@ func (a *API) ConversationsHistoryActivity(ctx context.Context, req *Conversatio
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
+// https://docs.slack.dev/reference/methods/conversations.history
+type ConversationsHistoryAllRequest struct {
+	ConversationsHistoryRequest
+
+	MaxItems int `json:"max_items,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/conversations.history
+type ConversationsHistoryAllResponse struct {
+	Messages   []Message `json:"messages,omitempty"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/conversations.history
+func (a *API) ConversationsHistoryAllActivity(ctx context.Context, req *ConversationsHistoryAllRequest) (*ConversationsHistoryAllResponse, error) {
+	items, next, err := paginate(ctx, req.Cursor, req.MaxItems, func(ctx context.Context, cursor string) ([]Message, string, error) {
+		page := req.ConversationsHistoryRequest
+		page.Cursor = cursor
+		resp, err := a.ConversationsHistoryActivity(ctx, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Messages, resp.nextCursor(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConversationsHistoryAllResponse{Messages: items, NextCursor: next}, nil
+}
+
 // https://docs.slack.dev/reference/methods/conversations.info
 type ConversationsInfoRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 
 	IncludeLocale     bool `json:"include_locale,omitempty"`
@@ -169,7 +243,7 @@ type ConversationsInfoRequest struct {
 type ConversationsInfoResponse struct {
 	slackResponse
 
-	Channel map[string]any `json:"channel,omitempty"`
+	Channel *Channel `json:"channel,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/conversations.info
@@ -188,13 +262,15 @@ func (a *API) ConversationsInfoActivity(ctx context.Context, req *ConversationsI
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/conversations.invite
 type ConversationsInviteRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 	Users   string `json:"users"`
 
@@ -205,7 +281,7 @@ type ConversationsInviteRequest struct {
 type ConversationsInviteResponse struct {
 	slackResponse
 
-	Channel map[string]any   `json:"channel,omitempty"`
+	Channel *Channel         `json:"channel,omitempty"`
 	Errors  []map[string]any `json:"errors,omitempty"`
 }
 
@@ -216,13 +292,15 @@ func (a *API) ConversationsInviteActivity(ctx context.Context, req *Conversation
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/conversations.join
 type ConversationsJoinRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 }
 
@@ -230,7 +308,7 @@ type ConversationsJoinRequest struct {
 type ConversationsJoinResponse struct {
 	slackResponse
 
-	Channel map[string]any `json:"channel,omitempty"`
+	Channel *Channel `json:"channel,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/conversations.join
@@ -240,13 +318,15 @@ func (a *API) ConversationsJoinActivity(ctx context.Context, req *ConversationsJ
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/conversations.kick
 type ConversationsKickRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 
 	User string `json:"user,omitempty"`
@@ -264,13 +344,15 @@ func (a *API) ConversationsKickActivity(ctx context.Context, req *ConversationsK
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/conversations.leave
 type ConversationsLeaveRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 }
 
@@ -288,13 +370,15 @@ func (a *API) ConversationsLeaveActivity(ctx context.Context, req *Conversations
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/conversations.list
 type ConversationsListRequest struct {
+	CallOptions
+
 	Cursor          string `json:"cursor,omitempty"`
 	ExcludeArchived bool   `json:"exclude_archived,omitempty"`
 	Limit           int    `json:"limit,omitempty"`
@@ -306,7 +390,7 @@ type ConversationsListRequest struct {
 type ConversationsListResponse struct {
 	slackResponse
 
-	Channels []map[string]any `json:"channels,omitempty"`
+	Channels []Channel `json:"channels,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/conversations.list
@@ -333,13 +417,46 @@ func (a *API) ConversationsListActivity(ctx context.Context, req *ConversationsL
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
+// https://docs.slack.dev/reference/methods/conversations.list
+type ConversationsListAllRequest struct {
+	ConversationsListRequest
+
+	MaxItems int `json:"max_items,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/conversations.list
+type ConversationsListAllResponse struct {
+	Channels   []Channel `json:"channels,omitempty"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/conversations.list
+func (a *API) ConversationsListAllActivity(ctx context.Context, req *ConversationsListAllRequest) (*ConversationsListAllResponse, error) {
+	items, next, err := paginate(ctx, req.Cursor, req.MaxItems, func(ctx context.Context, cursor string) ([]Channel, string, error) {
+		page := req.ConversationsListRequest
+		page.Cursor = cursor
+		resp, err := a.ConversationsListActivity(ctx, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Channels, resp.nextCursor(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConversationsListAllResponse{Channels: items, NextCursor: next}, nil
+}
+
 // https://docs.slack.dev/reference/methods/conversations.members
 type ConversationsMembersRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 
 	Cursor string `json:"cursor,omitempty"`
@@ -369,13 +486,46 @@ func (a *API) ConversationsMembersActivity(ctx context.Context, req *Conversatio
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
+// https://docs.slack.dev/reference/methods/conversations.members
+type ConversationsMembersAllRequest struct {
+	ConversationsMembersRequest
+
+	MaxItems int `json:"max_items,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/conversations.members
+type ConversationsMembersAllResponse struct {
+	Members    []string `json:"members,omitempty"`
+	NextCursor string   `json:"next_cursor,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/conversations.members
+func (a *API) ConversationsMembersAllActivity(ctx context.Context, req *ConversationsMembersAllRequest) (*ConversationsMembersAllResponse, error) {
+	items, next, err := paginate(ctx, req.Cursor, req.MaxItems, func(ctx context.Context, cursor string) ([]string, string, error) {
+		page := req.ConversationsMembersRequest
+		page.Cursor = cursor
+		resp, err := a.ConversationsMembersActivity(ctx, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Members, resp.nextCursor(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConversationsMembersAllResponse{Members: items, NextCursor: next}, nil
+}
+
 // https://docs.slack.dev/reference/methods/conversations.open
 type ConversationsOpenRequest struct {
+	CallOptions
+
 	Channel         string `json:"channel,omitempty"`
 	ReturnIM        bool   `json:"return_im,omitempty"`
 	Users           string `json:"users,omitempty"`
@@ -386,9 +536,9 @@ type ConversationsOpenRequest struct {
 type ConversationsOpenResponse struct {
 	slackResponse
 
-	NoOp        bool           `json:"no_op,omitempty"`
-	AlreadyOpen bool           `json:"already_open,omitempty"`
-	Channel     map[string]any `json:"channel,omitempty"`
+	NoOp        bool     `json:"no_op,omitempty"`
+	AlreadyOpen bool     `json:"already_open,omitempty"`
+	Channel     *Channel `json:"channel,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/conversations.open
@@ -398,13 +548,15 @@ func (a *API) ConversationsOpenActivity(ctx context.Context, req *ConversationsO
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/conversations.rename
 type ConversationsRenameRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 	Name    string `json:"name"`
 }
@@ -413,7 +565,7 @@ type ConversationsRenameRequest struct {
 type ConversationsRenameResponse struct {
 	slackResponse
 
-	Channel map[string]any `json:"channel,omitempty"`
+	Channel *Channel `json:"channel,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/conversations.rename
@@ -423,13 +575,15 @@ func (a *API) ConversationsRenameActivity(ctx context.Context, req *Conversation
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/conversations.replies
 type ConversationsRepliesRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 	TS      string `json:"ts"`
 
@@ -445,8 +599,8 @@ type ConversationsRepliesRequest struct {
 type ConversationsRepliesResponse struct {
 	slackResponse
 
-	Messages []map[string]any `json:"messages,omitempty"`
-	HasMore  bool             `json:"has_more,omitempty"`
+	Messages []Message `json:"messages,omitempty"`
+	HasMore  bool      `json:"has_more,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/conversations.replies
@@ -478,13 +632,46 @@ func (a *API) ConversationsRepliesActivity(ctx context.Context, req *Conversatio
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
+// https://docs.slack.dev/reference/methods/conversations.replies
+type ConversationsRepliesAllRequest struct {
+	ConversationsRepliesRequest
+
+	MaxItems int `json:"max_items,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/conversations.replies
+type ConversationsRepliesAllResponse struct {
+	Messages   []Message `json:"messages,omitempty"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/conversations.replies
+func (a *API) ConversationsRepliesAllActivity(ctx context.Context, req *ConversationsRepliesAllRequest) (*ConversationsRepliesAllResponse, error) {
+	items, next, err := paginate(ctx, req.Cursor, req.MaxItems, func(ctx context.Context, cursor string) ([]Message, string, error) {
+		page := req.ConversationsRepliesRequest
+		page.Cursor = cursor
+		resp, err := a.ConversationsRepliesActivity(ctx, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Messages, resp.nextCursor(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConversationsRepliesAllResponse{Messages: items, NextCursor: next}, nil
+}
+
 // https://docs.slack.dev/reference/methods/conversations.setPurpose
 type ConversationsSetPurposeRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 	Purpose string `json:"purpose"`
 }
@@ -493,7 +680,7 @@ type ConversationsSetPurposeRequest struct {
 type ConversationsSetPurposeResponse struct {
 	slackResponse
 
-	Channel map[string]any `json:"channel,omitempty"` // Empirically different from the documentation.
+	Channel *Channel `json:"channel,omitempty"` // Empirically different from the documentation.
 }
 
 // https://docs.slack.dev/reference/methods/conversations.setPurpose
@@ -503,13 +690,15 @@ func (a *API) ConversationsSetPurposeActivity(ctx context.Context, req *Conversa
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/conversations.setTopic
 type ConversationsSetTopicRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 	Topic   string `json:"topic"`
 }
@@ -518,7 +707,7 @@ type ConversationsSetTopicRequest struct {
 type ConversationsSetTopicResponse struct {
 	slackResponse
 
-	Channel map[string]any `json:"channel,omitempty"`
+	Channel *Channel `json:"channel,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/conversations.setTopic
@@ -528,13 +717,15 @@ func (a *API) ConversationsSetTopicActivity(ctx context.Context, req *Conversati
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/conversations.unarchive
 type ConversationsUnarchiveRequest struct {
+	CallOptions
+
 	Channel string `json:"channel"`
 }
 
@@ -550,7 +741,7 @@ func (a *API) ConversationsUnarchiveActivity(ctx context.Context, req *Conversat
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
@@ -0,0 +1,170 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+)
+
+const (
+	DndEndDndName    = "slack.dnd.endDnd"
+	DndEndSnoozeName = "slack.dnd.endSnooze"
+	DndInfoName      = "slack.dnd.info"
+	DndSetSnoozeName = "slack.dnd.setSnooze"
+	DndTeamInfoName  = "slack.dnd.teamInfo"
+)
+
+// DNDStatus is the Do Not Disturb status of a Slack user. Snooze fields
+// are included only in [API.DndInfoActivity] for the calling user,
+// and in the responses of snooze-related activities.
+//
+// https://docs.slack.dev/reference/methods/dnd.info
+type DNDStatus struct {
+	DNDEnabled     bool  `json:"dnd_enabled,omitempty"`
+	NextDNDStartTS int64 `json:"next_dnd_start_ts,omitempty"`
+	NextDNDEndTS   int64 `json:"next_dnd_end_ts,omitempty"`
+
+	SnoozeEnabled      bool  `json:"snooze_enabled,omitempty"`
+	SnoozeEndTime      int64 `json:"snooze_endtime,omitempty"`
+	SnoozeRemaining    int64 `json:"snooze_remaining,omitempty"`
+	SnoozeIsIndefinite bool  `json:"snooze_is_indefinite,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/dnd.endDnd
+type DndEndDndRequest struct {
+	CallOptions
+}
+
+// https://docs.slack.dev/reference/methods/dnd.endDnd
+type DndEndDndResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/dnd.endDnd
+func (a *API) DndEndDndActivity(ctx context.Context, req *DndEndDndRequest) (*DndEndDndResponse, error) {
+	resp := new(DndEndDndResponse)
+	if err := a.httpPost(ctx, DndEndDndName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/dnd.endSnooze
+type DndEndSnoozeRequest struct {
+	CallOptions
+}
+
+// https://docs.slack.dev/reference/methods/dnd.endSnooze
+type DndEndSnoozeResponse struct {
+	slackResponse
+	DNDStatus
+}
+
+// https://docs.slack.dev/reference/methods/dnd.endSnooze
+func (a *API) DndEndSnoozeActivity(ctx context.Context, req *DndEndSnoozeRequest) (*DndEndSnoozeResponse, error) {
+	resp := new(DndEndSnoozeResponse)
+	if err := a.httpPost(ctx, DndEndSnoozeName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/dnd.info
+type DndInfoRequest struct {
+	CallOptions
+
+	TeamID string `json:"team_id,omitempty"`
+	User   string `json:"user,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/dnd.info
+type DndInfoResponse struct {
+	slackResponse
+	DNDStatus
+}
+
+// https://docs.slack.dev/reference/methods/dnd.info
+func (a *API) DndInfoActivity(ctx context.Context, req *DndInfoRequest) (*DndInfoResponse, error) {
+	query := url.Values{}
+	if req.TeamID != "" {
+		query.Set("team_id", req.TeamID)
+	}
+	if req.User != "" {
+		query.Set("user", req.User)
+	}
+
+	resp := new(DndInfoResponse)
+	if err := a.httpGet(ctx, DndInfoName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/dnd.setSnooze
+type DndSetSnoozeRequest struct {
+	CallOptions
+
+	NumMinutes int `json:"num_minutes"`
+}
+
+// https://docs.slack.dev/reference/methods/dnd.setSnooze
+type DndSetSnoozeResponse struct {
+	slackResponse
+	DNDStatus
+}
+
+// https://docs.slack.dev/reference/methods/dnd.setSnooze
+func (a *API) DndSetSnoozeActivity(ctx context.Context, req *DndSetSnoozeRequest) (*DndSetSnoozeResponse, error) {
+	resp := new(DndSetSnoozeResponse)
+	if err := a.httpPost(ctx, DndSetSnoozeName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// Users is a comma-separated list of up to 50 user IDs.
+//
+// https://docs.slack.dev/reference/methods/dnd.teamInfo
+type DndTeamInfoRequest struct {
+	CallOptions
+
+	Users string `json:"users"`
+
+	TeamID string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/dnd.teamInfo
+type DndTeamInfoResponse struct {
+	slackResponse
+
+	Users map[string]DNDStatus `json:"users,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/dnd.teamInfo
+func (a *API) DndTeamInfoActivity(ctx context.Context, req *DndTeamInfoRequest) (*DndTeamInfoResponse, error) {
+	query := url.Values{}
+	query.Set("users", req.Users)
+	if req.TeamID != "" {
+		query.Set("team_id", req.TeamID)
+	}
+
+	resp := new(DndTeamInfoResponse)
+	if err := a.httpGet(ctx, DndTeamInfoName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
@@ -0,0 +1,45 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+)
+
+const (
+	EmojiListName = "slack.emoji.list"
+)
+
+// https://docs.slack.dev/reference/methods/emoji.list
+type EmojiListRequest struct {
+	CallOptions
+
+	IncludeCategories bool `json:"include_categories,omitempty"`
+}
+
+// EmojiListResponse maps the names of custom emojis to their image URLs,
+// or to "alias:<name>" if they are aliases of other emojis.
+//
+// https://docs.slack.dev/reference/methods/emoji.list
+type EmojiListResponse struct {
+	slackResponse
+
+	Emoji      map[string]string `json:"emoji,omitempty"`
+	Categories []map[string]any  `json:"categories,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/emoji.list
+func (a *API) EmojiListActivity(ctx context.Context, req *EmojiListRequest) (*EmojiListResponse, error) {
+	query := url.Values{}
+	if req.IncludeCategories {
+		query.Set("include_categories", "true")
+	}
+
+	resp := new(EmojiListResponse)
+	if err := a.httpGet(ctx, EmojiListName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
@@ -0,0 +1,155 @@
+package slack
+
+import (
+	"fmt"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+// Error is the cause of the [temporal.ApplicationError] that Slack activities
+// return when the Slack API responds with "ok": false. It is also attached to
+// that error as its details, and the error's type is the Slack error code
+// (e.g. "channel_not_found"), so workflows can branch on it:
+//
+//	var appErr *temporal.ApplicationError
+//	if errors.As(err, &appErr) && appErr.Type() == "missing_scope" {
+//		var slackErr slack.Error
+//		_ = appErr.Details(&slackErr)
+//		...
+//	}
+//
+// Whether the error is retryable depends on the Slack error code, as listed in
+// [retryableErrors]. Unknown error codes are considered non-retryable.
+//
+// https://docs.slack.dev/apis/web-api/#responses
+//
+// [temporal.ApplicationError]: https://pkg.go.dev/go.temporal.io/temporal#ApplicationError
+type Error struct {
+	Code             string            `json:"error"`
+	Needed           string            `json:"needed,omitempty"`
+	Provided         string            `json:"provided,omitempty"`
+	Warning          string            `json:"warning,omitempty"`
+	ResponseMetadata *ResponseMetadata `json:"response_metadata,omitempty"`
+}
+
+func (e *Error) Error() string {
+	msg := "Slack API error: " + e.Code
+	if e.Needed != "" {
+		msg = fmt.Sprintf("%s (needed: %q, provided: %q)", msg, e.Needed, e.Provided)
+	}
+	return msg
+}
+
+// Retryable reports whether the error is expected to be transient.
+func (e *Error) Retryable() bool {
+	return retryableErrors[e.Code]
+}
+
+// retryableErrors classifies known Slack API error codes as retryable (true)
+// or non-retryable (false). The list isn't exhaustive: it only covers common
+// errors (https://docs.slack.dev/reference/methods), and unknown ones are
+// treated as non-retryable, because most Slack API errors are deterministic.
+var retryableErrors = map[string]bool{
+	// Transient errors.
+	"fatal_error":         true,
+	"internal_error":      true,
+	"ratelimited":         true,
+	"request_timeout":     true,
+	"service_unavailable": true,
+	"team_added_to_org":   true,
+
+	// Authentication and authorization errors.
+	"access_denied":                       false,
+	"account_inactive":                    false,
+	"ekm_access_denied":                   false,
+	"enterprise_is_restricted":            false,
+	"invalid_auth":                        false,
+	"missing_scope":                       false,
+	"no_permission":                       false,
+	"not_allowed_token_type":              false,
+	"not_authed":                          false,
+	"org_login_required":                  false,
+	"restricted_action":                   false,
+	"restricted_action_read_only_channel": false,
+	"token_expired":                       false,
+	"token_revoked":                       false,
+	"two_factor_setup_required":           false,
+
+	// Enterprise Grid permission errors ("admin.*" methods). These require
+	// an org-level token of an admin or owner, and retrying won't help.
+	"feature_not_enabled":     false,
+	"not_allowed":             false,
+	"not_an_admin":            false,
+	"not_an_enterprise":       false,
+	"not_authorized":          false,
+	"team_access_not_granted": false,
+
+	// Malformed requests.
+	"deprecated_endpoint":  false,
+	"invalid_arg_name":     false,
+	"invalid_arguments":    false,
+	"invalid_array_arg":    false,
+	"invalid_blocks":       false,
+	"invalid_charset":      false,
+	"invalid_cursor":       false,
+	"invalid_form_data":    false,
+	"invalid_json":         false,
+	"invalid_post_type":    false,
+	"json_not_object":      false,
+	"method_deprecated":    false,
+	"missing_post_type":    false,
+	"msg_too_long":         false,
+	"no_text":              false,
+	"too_many_attachments": false,
+	"view_too_large":       false,
+
+	// Invalid state or references.
+	"already_archived":              false,
+	"already_in_team":               false,
+	"already_invited":               false,
+	"already_reacted":               false,
+	"canvas_not_found":              false,
+	"cant_delete_message":           false,
+	"cant_update_message":           false,
+	"channel_canvas_already_exists": false,
+	"channel_not_found":             false,
+	"edit_window_closed":            false,
+	"expired_trigger_id":            false,
+	"hash_conflict":                 false,
+	"invalid_email":                 false,
+	"invalid_presence":              false,
+	"invalid_profile":               false,
+	"invalid_scheduled_message_id":  false,
+	"is_archived":                   false,
+	"message_not_found":             false,
+	"name_taken":                    false,
+	"no_reaction":                   false,
+	"not_in_channel":                false,
+	"snooze_end_failed":             false,
+	"snooze_not_active":             false,
+	"time_in_past":                  false,
+	"time_too_far":                  false,
+	"too_many_reactions":            false,
+	"user_not_found":                false,
+	"users_not_found":               false,
+}
+
+// apiError converts an unsuccessful Slack API response into a [temporal.ApplicationError],
+// whose type is the Slack error code, and whose cause and details are an [Error].
+//
+// [temporal.ApplicationError]: https://pkg.go.dev/go.temporal.io/temporal#ApplicationError
+func (r *slackResponse) apiError() error {
+	err := &Error{
+		Code:             r.Error,
+		Needed:           r.Needed,
+		Provided:         r.Provided,
+		Warning:          r.Warning,
+		ResponseMetadata: r.ResponseMetadata,
+	}
+
+	return temporal.NewApplicationErrorWithOptions(err.Error(), err.Code, temporal.ApplicationErrorOptions{
+		NonRetryable: !err.Retryable(),
+		Cause:        err,
+		Details:      []any{err},
+	})
+}
@@ -0,0 +1,76 @@
+package slack
+
+import (
+	"errors"
+	"testing"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name          string
+		resp          slackResponse
+		wantMsg       string
+		wantRetryable bool
+	}{
+		{
+			name:          "retryable",
+			resp:          slackResponse{Error: "ratelimited"},
+			wantMsg:       "Slack API error: ratelimited",
+			wantRetryable: true,
+		},
+		{
+			name:    "non_retryable",
+			resp:    slackResponse{Error: "channel_not_found"},
+			wantMsg: "Slack API error: channel_not_found",
+		},
+		{
+			name:    "unknown",
+			resp:    slackResponse{Error: "unknown_error_code"},
+			wantMsg: "Slack API error: unknown_error_code",
+		},
+		{
+			name:    "admin_permission",
+			resp:    slackResponse{Error: "not_an_admin"},
+			wantMsg: "Slack API error: not_an_admin",
+		},
+		{
+			name: "missing_scope",
+			resp: slackResponse{
+				Error:    "missing_scope",
+				Needed:   "chat:write",
+				Provided: "channels:read",
+			},
+			wantMsg: `Slack API error: missing_scope (needed: "chat:write", provided: "channels:read")`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.resp.apiError()
+
+			appErr := new(temporal.ApplicationError)
+			if !errors.As(err, &appErr) {
+				t.Fatalf("apiError() = %v, want *temporal.ApplicationError", err)
+			}
+			if got := appErr.Type(); got != tt.resp.Error {
+				t.Errorf("apiError() type = %q, want %q", got, tt.resp.Error)
+			}
+			if got := appErr.NonRetryable(); got == tt.wantRetryable {
+				t.Errorf("apiError() non-retryable = %v, want %v", got, !tt.wantRetryable)
+			}
+
+			slackErr := new(Error)
+			if !errors.As(err, &slackErr) {
+				t.Fatalf("apiError() = %v, want *slack.Error", err)
+			}
+			if got := slackErr.Error(); got != tt.wantMsg {
+				t.Errorf("Error() = %q, want %q", got, tt.wantMsg)
+			}
+			if slackErr.Needed != tt.resp.Needed || slackErr.Provided != tt.resp.Provided {
+				t.Errorf("apiError() = %+v, want needed/provided of %+v", slackErr, tt.resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package slack
+
+// EventCallback is the outer payload of a Slack Events API request. Ovid passes
+// it as-is (except for the deprecated verification token) to the workflows that
+// it starts or signals in response to Slack events.
+//
+// https://docs.slack.dev/apis/events-api/#callback-field
+type EventCallback struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge,omitempty"` // Only in "url_verification".
+	Token     string `json:"token,omitempty"`     // Deprecated, not passed to workflows.
+
+	TeamID       string `json:"team_id,omitempty"`
+	EnterpriseID string `json:"enterprise_id,omitempty"`
+	APIAppID     string `json:"api_app_id,omitempty"`
+
+	Event          map[string]any   `json:"event,omitempty"`
+	EventID        string           `json:"event_id,omitempty"`
+	EventTime      int64            `json:"event_time,omitempty"`
+	EventContext   string           `json:"event_context,omitempty"`
+	Authorizations []map[string]any `json:"authorizations,omitempty"`
+
+	IsExtSharedChannel bool `json:"is_ext_shared_channel,omitempty"`
+}
+
+// EventType returns the type of the inner event (e.g. "app_mention",
+// "message", "reaction_added"), or an empty string if there isn't one.
+func (e *EventCallback) EventType() string {
+	return e.EventField("type")
+}
+
+// EventField returns the value of a top-level string field in the inner
+// event, or an empty string if it's not found or isn't a string.
+func (e *EventCallback) EventField(name string) string {
+	s, _ := e.Event[name].(string)
+	return s
+}
@@ -0,0 +1,424 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	altsrc "github.com/urfave/cli-altsrc/v3"
+	"github.com/urfave/cli-altsrc/v3/toml"
+	"github.com/urfave/cli/v3"
+	"go.temporal.io/sdk/temporal"
+)
+
+const (
+	FilesCompleteUploadExternalName = "slack.files.completeUploadExternal"
+	FilesDeleteName                 = "slack.files.delete"
+	FilesGetUploadURLExternalName   = "slack.files.getUploadURLExternal"
+	FilesInfoName                   = "slack.files.info"
+	FilesListName                   = "slack.files.list"
+	FilesSharedPublicURLName        = "slack.files.sharedPublicURL"
+	FilesUploadName                 = "slack.files.upload"      // Not a Slack API method.
+	FilesUploadToURLName            = "slack.files.uploadToURL" // Not a Slack API method.
+)
+
+// https://docs.slack.dev/reference/methods/files.completeUploadExternal
+type FilesCompleteUploadExternalRequest struct {
+	CallOptions
+
+	Files []FileSummary `json:"files"`
+
+	Blocks         []map[string]any `json:"blocks,omitempty"`
+	ChannelID      string           `json:"channel_id,omitempty"`
+	Channels       string           `json:"channels,omitempty"`
+	InitialComment string           `json:"initial_comment,omitempty"`
+	ThreadTS       string           `json:"thread_ts,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/files.completeUploadExternal
+type FileSummary struct {
+	ID    string `json:"id"`
+	Title string `json:"title,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/files.completeUploadExternal
+type FilesCompleteUploadExternalResponse struct {
+	slackResponse
+
+	Files []File `json:"files,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/files.completeUploadExternal
+func (a *API) FilesCompleteUploadExternalActivity(ctx context.Context, req *FilesCompleteUploadExternalRequest) (*FilesCompleteUploadExternalResponse, error) {
+	resp := new(FilesCompleteUploadExternalResponse)
+	if err := a.httpPost(ctx, FilesCompleteUploadExternalName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/files.delete
+type FilesDeleteRequest struct {
+	CallOptions
+
+	File string `json:"file"`
+}
+
+// https://docs.slack.dev/reference/methods/files.delete
+type FilesDeleteResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/files.delete
+func (a *API) FilesDeleteActivity(ctx context.Context, req *FilesDeleteRequest) (*FilesDeleteResponse, error) {
+	resp := new(FilesDeleteResponse)
+	if err := a.httpPost(ctx, FilesDeleteName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/files.getUploadURLExternal
+type FilesGetUploadURLExternalRequest struct {
+	CallOptions
+
+	Filename string `json:"filename"`
+	Length   int    `json:"length"`
+
+	AltText     string `json:"alt_txt,omitempty"`
+	SnippetType string `json:"snippet_type,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/files.getUploadURLExternal
+type FilesGetUploadURLExternalResponse struct {
+	slackResponse
+
+	UploadURL string `json:"upload_url,omitempty"`
+	FileID    string `json:"file_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/files.getUploadURLExternal
+func (a *API) FilesGetUploadURLExternalActivity(ctx context.Context, req *FilesGetUploadURLExternalRequest) (*FilesGetUploadURLExternalResponse, error) {
+	query := url.Values{}
+	query.Set("filename", req.Filename)
+	query.Set("length", strconv.Itoa(req.Length))
+	if req.AltText != "" {
+		query.Set("alt_txt", req.AltText)
+	}
+	if req.SnippetType != "" {
+		query.Set("snippet_type", req.SnippetType)
+	}
+
+	resp := new(FilesGetUploadURLExternalResponse)
+	if err := a.httpGet(ctx, FilesGetUploadURLExternalName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/files.info
+type FilesInfoRequest struct {
+	CallOptions
+
+	File string `json:"file"`
+
+	Cursor string `json:"cursor,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/files.info
+type FilesInfoResponse struct {
+	slackResponse
+
+	File     *File            `json:"file,omitempty"`
+	Comments []map[string]any `json:"comments,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/files.info
+func (a *API) FilesInfoActivity(ctx context.Context, req *FilesInfoRequest) (*FilesInfoResponse, error) {
+	query := url.Values{}
+	query.Set("file", req.File)
+	if req.Cursor != "" {
+		query.Set("cursor", req.Cursor)
+	}
+	if req.Limit != 0 {
+		query.Set("limit", strconv.Itoa(req.Limit))
+	}
+
+	resp := new(FilesInfoResponse)
+	if err := a.httpGet(ctx, FilesInfoName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/files.list
+type FilesListRequest struct {
+	CallOptions
+
+	Channel                string `json:"channel,omitempty"`
+	Count                  int    `json:"count,omitempty"`
+	Page                   int    `json:"page,omitempty"`
+	ShowFilesHiddenByLimit bool   `json:"show_files_hidden_by_limit,omitempty"`
+	TeamID                 string `json:"team_id,omitempty"`
+	TSFrom                 string `json:"ts_from,omitempty"`
+	TSTo                   string `json:"ts_to,omitempty"`
+	Types                  string `json:"types,omitempty"`
+	User                   string `json:"user,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/files.list
+type FilesListResponse struct {
+	slackResponse
+
+	Files  []File         `json:"files,omitempty"`
+	Paging map[string]any `json:"paging,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/files.list
+func (a *API) FilesListActivity(ctx context.Context, req *FilesListRequest) (*FilesListResponse, error) {
+	query := url.Values{}
+	if req.Channel != "" {
+		query.Set("channel", req.Channel)
+	}
+	if req.Count != 0 {
+		query.Set("count", strconv.Itoa(req.Count))
+	}
+	if req.Page != 0 {
+		query.Set("page", strconv.Itoa(req.Page))
+	}
+	if req.ShowFilesHiddenByLimit {
+		query.Set("show_files_hidden_by_limit", "true")
+	}
+	if req.TeamID != "" {
+		query.Set("team_id", req.TeamID)
+	}
+	if req.TSFrom != "" {
+		query.Set("ts_from", req.TSFrom)
+	}
+	if req.TSTo != "" {
+		query.Set("ts_to", req.TSTo)
+	}
+	if req.Types != "" {
+		query.Set("types", req.Types)
+	}
+	if req.User != "" {
+		query.Set("user", req.User)
+	}
+
+	resp := new(FilesListResponse)
+	if err := a.httpGet(ctx, FilesListName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/files.sharedPublicURL
+type FilesSharedPublicURLRequest struct {
+	CallOptions
+
+	File string `json:"file"`
+}
+
+// https://docs.slack.dev/reference/methods/files.sharedPublicURL
+type FilesSharedPublicURLResponse struct {
+	slackResponse
+
+	File *File `json:"file,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/files.sharedPublicURL
+func (a *API) FilesSharedPublicURLActivity(ctx context.Context, req *FilesSharedPublicURLRequest) (*FilesSharedPublicURLResponse, error) {
+	resp := new(FilesSharedPublicURLResponse)
+	if err := a.httpPost(ctx, FilesSharedPublicURLName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// FilesUploadToURLRequest is the second step of uploading a file to Slack:
+// sending its content to the upload URL that [API.FilesGetUploadURLExternalActivity]
+// returned. The upload URL must use HTTPS, and it doesn't receive any Slack token.
+//
+// https://docs.slack.dev/messaging/working-with-files#upload
+type FilesUploadToURLRequest struct {
+	CallOptions
+
+	UploadURL string `json:"upload_url"`
+	Content   []byte `json:"content"`
+}
+
+// https://docs.slack.dev/messaging/working-with-files#upload
+type FilesUploadToURLResponse struct{}
+
+// https://docs.slack.dev/messaging/working-with-files#upload
+func (a *API) FilesUploadToURLActivity(ctx context.Context, req *FilesUploadToURLRequest) (*FilesUploadToURLResponse, error) {
+	if err := a.httpUpload(ctx, req.UploadURL, req.Content); err != nil {
+		return nil, err
+	}
+	return &FilesUploadToURLResponse{}, nil
+}
+
+// UploadDirFlag defines a CLI flag for the local directory on the Ovid worker
+// from which [FilesUploadRequest] may read files to upload to Slack. This flag
+// can also be set using an environment variable and the application's
+// configuration file. If it's not set, uploads of local files are disabled.
+func UploadDirFlag(configFilePath altsrc.StringSourcer) cli.Flag {
+	return &cli.StringFlag{
+		Name:  "slack-upload-dir",
+		Usage: "local directory of files that Slack file upload activities may read",
+		Sources: cli.NewValueSourceChain(
+			cli.EnvVar("SLACK_UPLOAD_DIR"),
+			toml.TOML("slack.upload_dir", configFilePath),
+		),
+		TakesFile: true,
+	}
+}
+
+// FilesUploadRequest combines all the steps of uploading a file to Slack in
+// a single activity. The file's content is either specified directly, or read
+// from a local file path on the Ovid worker, which must be under the directory
+// in [UploadDirFlag] (relative paths are resolved against it). The file name
+// defaults to the base name of the path, if there is one.
+//
+// https://docs.slack.dev/messaging/working-with-files#upload
+type FilesUploadRequest struct {
+	CallOptions
+
+	Content []byte `json:"content,omitempty"`
+	Path    string `json:"path,omitempty"`
+
+	Filename    string `json:"filename,omitempty"`
+	Title       string `json:"title,omitempty"`
+	AltText     string `json:"alt_txt,omitempty"`
+	SnippetType string `json:"snippet_type,omitempty"`
+
+	Blocks         []map[string]any `json:"blocks,omitempty"`
+	ChannelID      string           `json:"channel_id,omitempty"`
+	Channels       string           `json:"channels,omitempty"`
+	InitialComment string           `json:"initial_comment,omitempty"`
+	ThreadTS       string           `json:"thread_ts,omitempty"`
+}
+
+// https://docs.slack.dev/messaging/working-with-files#upload
+func (a *API) FilesUploadActivity(ctx context.Context, req *FilesUploadRequest) (*FilesCompleteUploadExternalResponse, error) {
+	content, filename, err := uploadContent(req, a.uploadDir)
+	if err != nil {
+		return nil, err
+	}
+
+	resp1, err := a.FilesGetUploadURLExternalActivity(ctx, &FilesGetUploadURLExternalRequest{
+		Filename:    filename,
+		Length:      len(content),
+		AltText:     req.AltText,
+		SnippetType: req.SnippetType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.httpUpload(ctx, resp1.UploadURL, content); err != nil {
+		return nil, err
+	}
+
+	return a.FilesCompleteUploadExternalActivity(ctx, &FilesCompleteUploadExternalRequest{
+		Files:          []FileSummary{{ID: resp1.FileID, Title: req.Title}},
+		Blocks:         req.Blocks,
+		ChannelID:      req.ChannelID,
+		Channels:       req.Channels,
+		InitialComment: req.InitialComment,
+		ThreadTS:       req.ThreadTS,
+	})
+}
+
+// uploadContent returns the content and name of the file to upload in a
+// [FilesUploadRequest]. Local files are read only from the given root directory.
+func uploadContent(req *FilesUploadRequest, root string) ([]byte, string, error) {
+	filename := req.Filename
+	if filename == "" && req.Path != "" {
+		filename = filepath.Base(req.Path)
+	}
+	if filename == "" {
+		msg := "missing file name in Slack file upload request"
+		return nil, "", temporal.NewNonRetryableApplicationError(msg, "error", nil)
+	}
+
+	switch {
+	case req.Content != nil && req.Path != "":
+		msg := "Slack file upload request must specify either content or path, not both"
+		return nil, "", temporal.NewNonRetryableApplicationError(msg, "error", nil)
+	case req.Content != nil:
+		return req.Content, filename, nil
+	case req.Path == "":
+		msg := "Slack file upload request must specify either content or path"
+		return nil, "", temporal.NewNonRetryableApplicationError(msg, "error", nil)
+	}
+
+	content, err := readUploadFile(root, req.Path)
+	if err != nil {
+		msg := fmt.Sprintf("failed to read file to upload to Slack: %v", err)
+		return nil, "", temporal.NewNonRetryableApplicationError(msg, fmt.Sprintf("%T", err), err, req.Path)
+	}
+
+	return content, filename, nil
+}
+
+// readUploadFile reads a local file, but only if it's under the given root directory.
+// Relative paths are resolved against the root. Symbolic links are allowed, as long
+// as they don't point outside the root.
+func readUploadFile(root, path string) ([]byte, error) {
+	if root == "" {
+		return nil, errors.New("local file uploads are disabled (see the slack-upload-dir flag)")
+	}
+
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(root, path)
+	}
+
+	rel, err := filepath.Rel(root, filepath.Clean(path))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil, fmt.Errorf("path %q is outside the upload directory", path)
+	}
+
+	r, err := os.OpenRoot(root)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	f, err := r.Open(rel)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return io.ReadAll(f)
+}
@@ -0,0 +1,129 @@
+package slack
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+func TestUploadContent(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "file.txt")
+	if err := os.WriteFile(path, []byte("file content"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	outside := filepath.Join(t.TempDir(), "secret.txt")
+	if err := os.WriteFile(outside, []byte("secret"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(root, "link.txt")); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		req          *FilesUploadRequest
+		root         string
+		wantContent  string
+		wantFilename string
+		wantErr      bool
+	}{
+		{
+			name:         "content",
+			req:          &FilesUploadRequest{Content: []byte("content"), Filename: "name.txt"},
+			wantContent:  "content",
+			wantFilename: "name.txt",
+		},
+		{
+			name:    "content_without_filename",
+			req:     &FilesUploadRequest{Content: []byte("content")},
+			wantErr: true,
+		},
+		{
+			name:         "path",
+			req:          &FilesUploadRequest{Path: path},
+			root:         root,
+			wantContent:  "file content",
+			wantFilename: "file.txt",
+		},
+		{
+			name:         "relative_path",
+			req:          &FilesUploadRequest{Path: "file.txt"},
+			root:         root,
+			wantContent:  "file content",
+			wantFilename: "file.txt",
+		},
+		{
+			name:         "path_with_filename",
+			req:          &FilesUploadRequest{Path: path, Filename: "name.txt"},
+			root:         root,
+			wantContent:  "file content",
+			wantFilename: "name.txt",
+		},
+		{
+			name:    "missing_file",
+			req:     &FilesUploadRequest{Path: path + ".missing"},
+			root:    root,
+			wantErr: true,
+		},
+		{
+			name:    "no_upload_dir",
+			req:     &FilesUploadRequest{Path: path},
+			wantErr: true,
+		},
+		{
+			name:    "absolute_path_outside_upload_dir",
+			req:     &FilesUploadRequest{Path: outside},
+			root:    root,
+			wantErr: true,
+		},
+		{
+			name:    "relative_path_outside_upload_dir",
+			req:     &FilesUploadRequest{Path: "../" + filepath.Base(filepath.Dir(outside)) + "/secret.txt"},
+			root:    root,
+			wantErr: true,
+		},
+		{
+			name:    "symlink_outside_upload_dir",
+			req:     &FilesUploadRequest{Path: "link.txt"},
+			root:    root,
+			wantErr: true,
+		},
+		{
+			name:    "content_and_path",
+			req:     &FilesUploadRequest{Content: []byte("content"), Path: path},
+			wantErr: true,
+		},
+		{
+			name:    "neither",
+			req:     &FilesUploadRequest{Filename: "name.txt"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, filename, err := uploadContent(tt.req, tt.root)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("uploadContent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				var appErr *temporal.ApplicationError
+				if !errors.As(err, &appErr) || !appErr.NonRetryable() {
+					t.Errorf("uploadContent() error = %v, want non-retryable error", err)
+				}
+				return
+			}
+			if string(content) != tt.wantContent {
+				t.Errorf("uploadContent() content = %q, want %q", content, tt.wantContent)
+			}
+			if filename != tt.wantFilename {
+				t.Errorf("uploadContent() filename = %q, want %q", filename, tt.wantFilename)
+			}
+		})
+	}
+}
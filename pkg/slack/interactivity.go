@@ -0,0 +1,191 @@
+package slack
+
+import (
+	"strings"
+
+	"github.com/tzrikka/ovid/pkg/slack/blocks"
+)
+
+// InteractionPayload is sent by Slack when users interact with Block Kit
+// elements in messages and views, or submit or close modal views.
+//
+// https://docs.slack.dev/reference/interaction-payloads
+type InteractionPayload struct {
+	Type  string `json:"type"`            // E.g. "block_actions", "view_submission", "view_closed".
+	Token string `json:"token,omitempty"` // Deprecated, not passed to workflows.
+
+	APIAppID            string   `json:"api_app_id,omitempty"`
+	Team                *Team    `json:"team,omitempty"`
+	Enterprise          *Team    `json:"enterprise,omitempty"`
+	IsEnterpriseInstall bool     `json:"is_enterprise_install,omitempty"`
+	User                *User    `json:"user,omitempty"`
+	Channel             *Channel `json:"channel,omitempty"`
+
+	TriggerID    string           `json:"trigger_id,omitempty"`
+	ResponseURL  string           `json:"response_url,omitempty"`
+	ResponseURLs []map[string]any `json:"response_urls,omitempty"`
+
+	// Only in "block_actions".
+	Actions   []BlockAction  `json:"actions,omitempty"`
+	Container map[string]any `json:"container,omitempty"`
+	Message   *Message       `json:"message,omitempty"`
+	State     *ViewState     `json:"state,omitempty"`
+
+	// Only in "block_actions" in views, "view_submission" and "view_closed".
+	View      *View `json:"view,omitempty"`
+	IsCleared bool  `json:"is_cleared,omitempty"` // Only in "view_closed".
+}
+
+// BlockAction is a single interaction with a Block Kit interactive
+// element in an [InteractionPayload] of type "block_actions".
+//
+// https://docs.slack.dev/reference/interaction-payloads/block_actions-payload
+type BlockAction struct {
+	Type     string       `json:"type"`
+	ActionID string       `json:"action_id"`
+	BlockID  string       `json:"block_id,omitempty"`
+	ActionTS string       `json:"action_ts,omitempty"`
+	Text     *blocks.Text `json:"text,omitempty"`
+	Value    string       `json:"value,omitempty"`
+	Style    string       `json:"style,omitempty"`
+
+	SelectedOption        *blocks.Option   `json:"selected_option,omitempty"`
+	SelectedOptions       []*blocks.Option `json:"selected_options,omitempty"`
+	SelectedUser          string           `json:"selected_user,omitempty"`
+	SelectedUsers         []string         `json:"selected_users,omitempty"`
+	SelectedChannel       string           `json:"selected_channel,omitempty"`
+	SelectedChannels      []string         `json:"selected_channels,omitempty"`
+	SelectedConversation  string           `json:"selected_conversation,omitempty"`
+	SelectedConversations []string         `json:"selected_conversations,omitempty"`
+	SelectedDate          string           `json:"selected_date,omitempty"`
+}
+
+// View is a Slack modal or App Home view object. Fields which
+// aren't defined explicitly in this struct are preserved in Extra.
+//
+// https://docs.slack.dev/reference/views
+type View struct {
+	ID     string `json:"id,omitempty"`
+	TeamID string `json:"team_id,omitempty"`
+	Type   string `json:"type"` // "modal" or "home".
+
+	Title  *blocks.Text     `json:"title,omitempty"`
+	Submit *blocks.Text     `json:"submit,omitempty"`
+	Close  *blocks.Text     `json:"close,omitempty"`
+	Blocks []map[string]any `json:"blocks"`
+
+	PrivateMetadata string `json:"private_metadata,omitempty"`
+	CallbackID      string `json:"callback_id,omitempty"`
+	ExternalID      string `json:"external_id,omitempty"`
+	ClearOnClose    bool   `json:"clear_on_close,omitempty"`
+	NotifyOnClose   bool   `json:"notify_on_close,omitempty"`
+	SubmitDisabled  bool   `json:"submit_disabled,omitempty"`
+
+	State          *ViewState `json:"state,omitempty"`
+	Hash           string     `json:"hash,omitempty"`
+	RootViewID     string     `json:"root_view_id,omitempty"`
+	PreviousViewID string     `json:"previous_view_id,omitempty"`
+	AppID          string     `json:"app_id,omitempty"`
+	BotID          string     `json:"bot_id,omitempty"`
+
+	Extra map[string]any `json:"-"`
+}
+
+func (v *View) UnmarshalJSON(b []byte) error {
+	type view View
+	extra, err := unmarshalWithExtra(b, (*view)(v))
+	v.Extra = extra
+	return err
+}
+
+func (v View) MarshalJSON() ([]byte, error) {
+	type view View
+	return marshalWithExtra(view(v), v.Extra)
+}
+
+// ViewState contains the current values of input elements in a view or a message,
+// keyed by block ID and then by action ID. Each value is a [BlockAction] without
+// an action ID, in which only the relevant "Value" or "Selected..." field is set.
+//
+// https://docs.slack.dev/reference/interaction-payloads/view-interactions-payload#view_submission
+type ViewState struct {
+	Values map[string]map[string]BlockAction `json:"values"`
+}
+
+// SlashCommand is sent by Slack when users invoke a slash command.
+//
+// https://docs.slack.dev/interactivity/implementing-slash-commands#app_command_handling
+type SlashCommand struct {
+	Command string `json:"command"`
+	Text    string `json:"text"`
+	Token   string `json:"token,omitempty"` // Deprecated, not passed to workflows.
+
+	APIAppID            string `json:"api_app_id,omitempty"`
+	TeamID              string `json:"team_id,omitempty"`
+	TeamDomain          string `json:"team_domain,omitempty"`
+	EnterpriseID        string `json:"enterprise_id,omitempty"`
+	EnterpriseName      string `json:"enterprise_name,omitempty"`
+	IsEnterpriseInstall string `json:"is_enterprise_install,omitempty"`
+	ChannelID           string `json:"channel_id,omitempty"`
+	ChannelName         string `json:"channel_name,omitempty"`
+	UserID              string `json:"user_id,omitempty"`
+	UserName            string `json:"user_name,omitempty"`
+
+	TriggerID   string `json:"trigger_id,omitempty"`
+	ResponseURL string `json:"response_url,omitempty"`
+}
+
+const workflowTargetPrefix = "ovid:"
+
+// WorkflowTarget identifies a Temporal workflow which is waiting for a Slack
+// interaction, e.g. an approval button click. Use its string encoding as the
+// action ID of a Block Kit interactive element, or as the callback ID of a
+// modal view, and Ovid will deliver the [InteractionPayload] to that workflow
+// as a signal or an update, regardless of the interactivity routing table.
+//
+// Signal and update names, and tags, must not contain colons. Tag is optional: it
+// distinguishes between elements in the same block (e.g. "approve" and
+// "deny" buttons), because their action IDs must be unique.
+type WorkflowTarget struct {
+	WorkflowID string
+	Signal     string // Exactly one of Signal and Update.
+	Update     string
+	Tag        string
+}
+
+// String encodes the target as "ovid:signal:<name>:<tag>:<workflow ID>" or
+// "ovid:update:<name>:<tag>:<workflow ID>". Block Kit action IDs are limited
+// to 255 characters, so workflow IDs in them should be reasonably short.
+func (t WorkflowTarget) String() string {
+	kind, name := "signal", t.Signal
+	if t.Update != "" {
+		kind, name = "update", t.Update
+	}
+	return workflowTargetPrefix + strings.Join([]string{kind, name, t.Tag, t.WorkflowID}, ":")
+}
+
+// ParseWorkflowTarget decodes the string encoding of a [WorkflowTarget]. It returns
+// false if the string is a regular action or callback ID, or if it's malformed.
+func ParseWorkflowTarget(s string) (WorkflowTarget, bool) {
+	s, found := strings.CutPrefix(s, workflowTargetPrefix)
+	if !found {
+		return WorkflowTarget{}, false
+	}
+
+	parts := strings.SplitN(s, ":", 4)
+	if len(parts) < 4 || parts[1] == "" || parts[3] == "" {
+		return WorkflowTarget{}, false
+	}
+
+	t := WorkflowTarget{WorkflowID: parts[3], Tag: parts[2]}
+	switch parts[0] {
+	case "signal":
+		t.Signal = parts[1]
+	case "update":
+		t.Update = parts[1]
+	default:
+		return WorkflowTarget{}, false
+	}
+
+	return t, true
+}
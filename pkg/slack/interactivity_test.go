@@ -0,0 +1,59 @@
+package slack
+
+import (
+	"testing"
+)
+
+func TestWorkflowTarget(t *testing.T) {
+	tests := []struct {
+		name   string
+		target WorkflowTarget
+		want   string
+	}{
+		{
+			name:   "signal",
+			target: WorkflowTarget{WorkflowID: "wid", Signal: "decision"},
+			want:   "ovid:signal:decision::wid",
+		},
+		{
+			name:   "signal_with_tag",
+			target: WorkflowTarget{WorkflowID: "wid", Signal: "decision", Tag: "approve"},
+			want:   "ovid:signal:decision:approve:wid",
+		},
+		{
+			name:   "update_with_colons_in_workflow_id",
+			target: WorkflowTarget{WorkflowID: "a:b:c", Update: "edit"},
+			want:   "ovid:update:edit::a:b:c",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := tt.target.String()
+			if s != tt.want {
+				t.Errorf("String() = %q, want %q", s, tt.want)
+			}
+
+			got, ok := ParseWorkflowTarget(s)
+			if !ok || got != tt.target {
+				t.Errorf("ParseWorkflowTarget() = %+v, %v, want %+v", got, ok, tt.target)
+			}
+		})
+	}
+}
+
+func TestParseWorkflowTargetInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"approve",
+		"ovid:",
+		"ovid:signal:decision:tag",
+		"ovid:signal::tag:wid",
+		"ovid:signal:decision:tag:",
+		"ovid:query:name:tag:wid",
+	} {
+		if got, ok := ParseWorkflowTarget(s); ok {
+			t.Errorf("ParseWorkflowTarget(%q) = %+v, want false", s, got)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	altsrc "github.com/urfave/cli-altsrc/v3"
+	"github.com/urfave/cli/v3"
+
+	"github.com/tzrikka/ovid/internal/thrippy"
+	"github.com/tzrikka/ovid/pkg/client"
+)
+
+// defaultLinkName is the name of the default link in the table of named links.
+const defaultLinkName = "default"
+
+// linksSource is a [cli.ValueSource] for the "thrippy.links.slack" key in the
+// application's configuration file. Its value is either a single Thrippy link
+// ID, or a table of named link IDs (one per Slack workspace), in which the
+// default link is named "default":
+//
+//	[thrippy.links.slack]
+//	default = "..."
+//	gov = "..."
+//
+// If named is false, the source returns the default link ID. Otherwise,
+// it returns all the named link IDs in the format of a [cli.StringMapFlag].
+type linksSource struct {
+	path  altsrc.StringSourcer
+	named bool
+}
+
+var _ cli.ValueSource = linksSource{}
+
+func (s linksSource) Lookup() (string, bool) {
+	links, ok := s.links()
+	if !ok {
+		return "", false
+	}
+
+	switch v := links.(type) {
+	case string:
+		if s.named {
+			return "", false
+		}
+		return v, v != ""
+	case map[string]any:
+		if !s.named {
+			id, ok := v[defaultLinkName].(string)
+			return id, ok && id != ""
+		}
+		var pairs []string
+		for name, id := range v {
+			if id, ok := id.(string); ok && id != "" {
+				pairs = append(pairs, name+"="+id)
+			}
+		}
+		slices.Sort(pairs)
+		return strings.Join(pairs, ","), len(pairs) > 0
+	default:
+		return "", false
+	}
+}
+
+// links returns the raw value of the "thrippy.links.slack" key in the configuration file.
+func (s linksSource) links() (any, bool) {
+	path := s.path.SourceURI()
+	if path == "" {
+		return nil, false
+	}
+
+	b, err := os.ReadFile(path) //gosec:disable G304 -- user-specified file by design
+	if err != nil {
+		return nil, false
+	}
+
+	var cfg struct {
+		Thrippy struct {
+			Links struct {
+				Slack any `toml:"slack"`
+			} `toml:"links"`
+		} `toml:"thrippy"`
+	}
+	if _, err := toml.Decode(string(b), &cfg); err != nil {
+		return nil, false
+	}
+
+	return cfg.Thrippy.Links.Slack, cfg.Thrippy.Links.Slack != nil
+}
+
+func (s linksSource) String() string {
+	return fmt.Sprintf("toml file %q at key %q", s.path.SourceURI(), "thrippy.links.slack")
+}
+
+func (s linksSource) GoString() string {
+	return fmt.Sprintf("linksSource{file:%q,named:%t}", s.path.SourceURI(), s.named)
+}
+
+// newAPI initializes the Thrippy link clients of all the configured Slack
+// workspaces, without a rate limiter. If the HTTP client is nil, the API
+// uses one with default options.
+func newAPI(cmd *cli.Command, c *client.Client) *API {
+	if c == nil {
+		c = client.New()
+	}
+
+	a := &API{
+		thrippy: thrippy.NewLinkClient(cmd.String("thrippy-link-slack"), cmd),
+		links:   map[string]thrippy.LinkClient{},
+		client:  c,
+	}
+
+	for name, id := range cmd.StringMap("thrippy-links-slack") {
+		a.links[name] = thrippy.NewLinkClient(id, cmd)
+	}
+
+	return a
+}
+
+// NewStaticAPI initializes an [API] which doesn't depend on Thrippy: it sends
+// requests to the given base URL instead of "https://slack.com", with the given
+// static credentials (e.g. "bot_token" and "user_token") regardless of the link
+// in [CallOptions], and without Slack's rate limits. This is meant for tests,
+// e.g. with the fake Slack server in the "slacktest" package. If the HTTP client
+// is nil, the API uses one with default options.
+func NewStaticAPI(baseURL string, creds map[string]string, c *client.Client) *API {
+	if c == nil {
+		c = client.New()
+	}
+
+	return &API{
+		linkData: func(_ context.Context, _ thrippy.LinkClient) (string, map[string]string, error) {
+			return "slack-bot-token", creds, nil
+		},
+		baseURL: baseURL,
+		client:  c,
+	}
+}
+
+// link returns the Thrippy link client of a Slack API call: the one which is named or
+// identified in the context's [CallOptions] if there is one, or else the default one.
+func (a *API) link(ctx context.Context) thrippy.LinkClient {
+	o, _ := ctx.Value(callOptionsKey{}).(CallOptions)
+	if o.Link == "" {
+		return a.thrippy
+	}
+
+	if t, ok := a.links[o.Link]; ok {
+		return t
+	}
+
+	t := a.thrippy
+	t.LinkID = o.Link
+	return t
+}
+
+// linkDataFunc returns the template name and saved secrets of a Thrippy link.
+type linkDataFunc func(ctx context.Context, t thrippy.LinkClient) (string, map[string]string, error)
+
+// data returns the template name and saved secrets of the given Thrippy link,
+// from the Thrippy service or from the receiver's override, if there is one.
+func (a *API) data(ctx context.Context, t thrippy.LinkClient) (string, map[string]string, error) {
+	if a.linkData != nil {
+		return a.linkData(ctx, t)
+	}
+	return t.LinkData(ctx, "slack")
+}
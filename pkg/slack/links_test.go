@@ -0,0 +1,96 @@
+package slack
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	altsrc "github.com/urfave/cli-altsrc/v3"
+
+	"github.com/tzrikka/ovid/internal/thrippy"
+)
+
+func TestLinksSource(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    string
+		wantID    string
+		wantNamed string
+	}{
+		{
+			name: "missing",
+		},
+		{
+			name:   "single_link",
+			config: "[thrippy.links]\nslack = \"id1\"\n",
+			wantID: "id1",
+		},
+		{
+			name:      "named_links",
+			config:    "[thrippy.links.slack]\ndefault = \"id1\"\ngov = \"id2\"\n",
+			wantID:    "id1",
+			wantNamed: "default=id1,gov=id2",
+		},
+		{
+			name:      "named_links_without_default",
+			config:    "[thrippy.links.slack]\ngov = \"id2\"\n",
+			wantNamed: "gov=id2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.toml")
+			if err := os.WriteFile(path, []byte(tt.config), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			got, ok := linksSource{path: altsrc.StringSourcer(path)}.Lookup()
+			if got != tt.wantID || ok != (tt.wantID != "") {
+				t.Errorf("Lookup() = %q, %t, want %q", got, ok, tt.wantID)
+			}
+
+			got, ok = linksSource{path: altsrc.StringSourcer(path), named: true}.Lookup()
+			if got != tt.wantNamed || ok != (tt.wantNamed != "") {
+				t.Errorf("Lookup(named) = %q, %t, want %q", got, ok, tt.wantNamed)
+			}
+		})
+	}
+}
+
+func TestLink(t *testing.T) {
+	a := &API{
+		thrippy: thrippy.LinkClient{LinkID: "id1"},
+		links:   map[string]thrippy.LinkClient{"gov": {LinkID: "id2"}},
+	}
+
+	tests := []struct {
+		name string
+		link string
+		want string
+	}{
+		{
+			name: "default",
+			want: "id1",
+		},
+		{
+			name: "named",
+			link: "gov",
+			want: "id2",
+		},
+		{
+			name: "id",
+			link: "id3",
+			want: "id3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := withCallOptions(t.Context(), &AuthTestRequest{CallOptions: CallOptions{Link: tt.link}})
+			if got := a.link(ctx).LinkID; got != tt.want {
+				t.Errorf("link() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,421 @@
+package slack
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Channel is a Slack conversation object. Fields which aren't
+// defined explicitly in this struct are preserved in Extra.
+//
+// https://docs.slack.dev/reference/objects/conversation-object
+type Channel struct {
+	ID             string `json:"id"`
+	Name           string `json:"name,omitempty"`
+	NameNormalized string `json:"name_normalized,omitempty"`
+
+	IsChannel  bool `json:"is_channel,omitempty"`
+	IsGroup    bool `json:"is_group,omitempty"`
+	IsIM       bool `json:"is_im,omitempty"`
+	IsMPIM     bool `json:"is_mpim,omitempty"`
+	IsPrivate  bool `json:"is_private,omitempty"`
+	IsArchived bool `json:"is_archived,omitempty"`
+	IsGeneral  bool `json:"is_general,omitempty"`
+	IsMember   bool `json:"is_member,omitempty"`
+
+	IsShared           bool     `json:"is_shared,omitempty"`
+	IsExtShared        bool     `json:"is_ext_shared,omitempty"`
+	IsOrgShared        bool     `json:"is_org_shared,omitempty"`
+	IsPendingExtShared bool     `json:"is_pending_ext_shared,omitempty"`
+	SharedTeamIDs      []string `json:"shared_team_ids,omitempty"`
+	ContextTeamID      string   `json:"context_team_id,omitempty"`
+
+	Created  int64  `json:"created,omitempty"`
+	Creator  string `json:"creator,omitempty"`
+	Updated  int64  `json:"updated,omitempty"`
+	Unlinked int64  `json:"unlinked,omitempty"`
+
+	Topic         *Topic   `json:"topic,omitempty"`
+	Purpose       *Topic   `json:"purpose,omitempty"`
+	PreviousNames []string `json:"previous_names,omitempty"`
+	NumMembers    int      `json:"num_members,omitempty"`
+	Locale        string   `json:"locale,omitempty"`
+
+	// Direct messages only.
+	User          string `json:"user,omitempty"`
+	IsUserDeleted bool   `json:"is_user_deleted,omitempty"`
+	LastRead      string `json:"last_read,omitempty"`
+
+	Extra map[string]any `json:"-"`
+}
+
+func (c *Channel) UnmarshalJSON(b []byte) error {
+	type channel Channel
+	extra, err := unmarshalWithExtra(b, (*channel)(c))
+	c.Extra = extra
+	return err
+}
+
+func (c Channel) MarshalJSON() ([]byte, error) {
+	type channel Channel
+	return marshalWithExtra(channel(c), c.Extra)
+}
+
+// Topic is the topic or the purpose of a Slack [Channel].
+type Topic struct {
+	Value   string `json:"value"`
+	Creator string `json:"creator,omitempty"`
+	LastSet int64  `json:"last_set,omitempty"`
+}
+
+// User is a Slack user object. Fields which aren't
+// defined explicitly in this struct are preserved in Extra.
+//
+// https://docs.slack.dev/reference/objects/user-object
+type User struct {
+	ID       string `json:"id"`
+	TeamID   string `json:"team_id,omitempty"`
+	Name     string `json:"name,omitempty"`
+	RealName string `json:"real_name,omitempty"`
+	Deleted  bool   `json:"deleted,omitempty"`
+	Color    string `json:"color,omitempty"`
+
+	TZ       string `json:"tz,omitempty"`
+	TZLabel  string `json:"tz_label,omitempty"`
+	TZOffset int    `json:"tz_offset,omitempty"`
+	Locale   string `json:"locale,omitempty"`
+
+	Profile *Profile `json:"profile,omitempty"`
+
+	IsAdmin           bool `json:"is_admin,omitempty"`
+	IsOwner           bool `json:"is_owner,omitempty"`
+	IsPrimaryOwner    bool `json:"is_primary_owner,omitempty"`
+	IsRestricted      bool `json:"is_restricted,omitempty"`
+	IsUltraRestricted bool `json:"is_ultra_restricted,omitempty"`
+	IsBot             bool `json:"is_bot,omitempty"`
+	IsAppUser         bool `json:"is_app_user,omitempty"`
+	IsEmailConfirmed  bool `json:"is_email_confirmed,omitempty"`
+	Has2FA            bool `json:"has_2fa,omitempty"`
+
+	Updated int64 `json:"updated,omitempty"`
+
+	Extra map[string]any `json:"-"`
+}
+
+func (u *User) UnmarshalJSON(b []byte) error {
+	type user User
+	extra, err := unmarshalWithExtra(b, (*user)(u))
+	u.Extra = extra
+	return err
+}
+
+func (u User) MarshalJSON() ([]byte, error) {
+	type user User
+	return marshalWithExtra(user(u), u.Extra)
+}
+
+// Profile is a Slack user's profile. Fields which aren't defined explicitly
+// in this struct (e.g. custom profile fields) are preserved in Extra.
+//
+// https://docs.slack.dev/reference/objects/user-object#profile
+type Profile struct {
+	RealName              string `json:"real_name,omitempty"`
+	RealNameNormalized    string `json:"real_name_normalized,omitempty"`
+	DisplayName           string `json:"display_name,omitempty"`
+	DisplayNameNormalized string `json:"display_name_normalized,omitempty"`
+	FirstName             string `json:"first_name,omitempty"`
+	LastName              string `json:"last_name,omitempty"`
+	Title                 string `json:"title,omitempty"`
+	Pronouns              string `json:"pronouns,omitempty"`
+	Email                 string `json:"email,omitempty"`
+	Phone                 string `json:"phone,omitempty"`
+	Skype                 string `json:"skype,omitempty"`
+	Team                  string `json:"team,omitempty"`
+
+	StatusText       string `json:"status_text,omitempty"`
+	StatusEmoji      string `json:"status_emoji,omitempty"`
+	StatusExpiration int64  `json:"status_expiration,omitempty"`
+
+	AvatarHash    string `json:"avatar_hash,omitempty"`
+	IsCustomImage bool   `json:"is_custom_image,omitempty"`
+	Image24       string `json:"image_24,omitempty"`
+	Image32       string `json:"image_32,omitempty"`
+	Image48       string `json:"image_48,omitempty"`
+	Image72       string `json:"image_72,omitempty"`
+	Image192      string `json:"image_192,omitempty"`
+	Image512      string `json:"image_512,omitempty"`
+	Image1024     string `json:"image_1024,omitempty"`
+	ImageOriginal string `json:"image_original,omitempty"`
+
+	// Bot users only.
+	BotID        string `json:"bot_id,omitempty"`
+	APIAppID     string `json:"api_app_id,omitempty"`
+	AlwaysActive bool   `json:"always_active,omitempty"`
+
+	Extra map[string]any `json:"-"`
+}
+
+func (p *Profile) UnmarshalJSON(b []byte) error {
+	type profile Profile
+	extra, err := unmarshalWithExtra(b, (*profile)(p))
+	p.Extra = extra
+	return err
+}
+
+func (p Profile) MarshalJSON() ([]byte, error) {
+	type profile Profile
+	return marshalWithExtra(profile(p), p.Extra)
+}
+
+// Message is a Slack message object. Fields which aren't
+// defined explicitly in this struct are preserved in Extra.
+//
+// https://docs.slack.dev/messaging/message-formatting
+type Message struct {
+	Type    string `json:"type,omitempty"`
+	Subtype string `json:"subtype,omitempty"`
+	TS      string `json:"ts,omitempty"`
+	Channel string `json:"channel,omitempty"`
+	Team    string `json:"team,omitempty"`
+
+	User     string `json:"user,omitempty"`
+	BotID    string `json:"bot_id,omitempty"`
+	AppID    string `json:"app_id,omitempty"`
+	Username string `json:"username,omitempty"`
+
+	Text        string           `json:"text,omitempty"`
+	Blocks      []map[string]any `json:"blocks,omitempty"`
+	Attachments []map[string]any `json:"attachments,omitempty"`
+	Files       []File           `json:"files,omitempty"`
+	Reactions   []Reaction       `json:"reactions,omitempty"`
+	Metadata    map[string]any   `json:"metadata,omitempty"`
+	Edited      *Edited          `json:"edited,omitempty"`
+	Permalink   string           `json:"permalink,omitempty"`
+
+	// Threads.
+	ThreadTS        string   `json:"thread_ts,omitempty"`
+	ParentUserID    string   `json:"parent_user_id,omitempty"`
+	ReplyCount      int      `json:"reply_count,omitempty"`
+	ReplyUsersCount int      `json:"reply_users_count,omitempty"`
+	ReplyUsers      []string `json:"reply_users,omitempty"`
+	LatestReply     string   `json:"latest_reply,omitempty"`
+	IsLocked        bool     `json:"is_locked,omitempty"`
+	Subscribed      bool     `json:"subscribed,omitempty"`
+
+	Extra map[string]any `json:"-"`
+}
+
+func (m *Message) UnmarshalJSON(b []byte) error {
+	type message Message
+	extra, err := unmarshalWithExtra(b, (*message)(m))
+	m.Extra = extra
+	return err
+}
+
+func (m Message) MarshalJSON() ([]byte, error) {
+	type message Message
+	return marshalWithExtra(message(m), m.Extra)
+}
+
+// Edited indicates when and by whom a Slack [Message] was last edited.
+type Edited struct {
+	User string `json:"user"`
+	TS   string `json:"ts"`
+}
+
+// Reaction is an emoji reaction to a Slack [Message] or [File].
+//
+// https://docs.slack.dev/reference/methods/reactions.get
+type Reaction struct {
+	Name  string   `json:"name"`
+	Count int      `json:"count,omitempty"`
+	Users []string `json:"users,omitempty"`
+}
+
+// ReactionItem is an item (message or file) which a user reacted to.
+// Fields which aren't defined explicitly in this struct are preserved in Extra.
+//
+// https://docs.slack.dev/reference/methods/reactions.list
+type ReactionItem struct {
+	Type    string   `json:"type"`
+	Channel string   `json:"channel,omitempty"`
+	Message *Message `json:"message,omitempty"`
+	File    *File    `json:"file,omitempty"`
+
+	Extra map[string]any `json:"-"`
+}
+
+func (r *ReactionItem) UnmarshalJSON(b []byte) error {
+	type reactionItem ReactionItem
+	extra, err := unmarshalWithExtra(b, (*reactionItem)(r))
+	r.Extra = extra
+	return err
+}
+
+func (r ReactionItem) MarshalJSON() ([]byte, error) {
+	type reactionItem ReactionItem
+	return marshalWithExtra(reactionItem(r), r.Extra)
+}
+
+// File is a Slack file object. Fields which aren't defined explicitly
+// in this struct (e.g. thumbnails) are preserved in Extra.
+//
+// https://docs.slack.dev/reference/objects/file-object
+type File struct {
+	ID         string `json:"id"`
+	Created    int64  `json:"created,omitempty"`
+	Timestamp  int64  `json:"timestamp,omitempty"`
+	User       string `json:"user,omitempty"`
+	UserTeam   string `json:"user_team,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Title      string `json:"title,omitempty"`
+	AltText    string `json:"alt_txt,omitempty"`
+	Mimetype   string `json:"mimetype,omitempty"`
+	Filetype   string `json:"filetype,omitempty"`
+	PrettyType string `json:"pretty_type,omitempty"`
+	Size       int64  `json:"size,omitempty"`
+	Mode       string `json:"mode,omitempty"`
+
+	IsExternal      bool   `json:"is_external,omitempty"`
+	ExternalType    string `json:"external_type,omitempty"`
+	IsPublic        bool   `json:"is_public,omitempty"`
+	PublicURLShared bool   `json:"public_url_shared,omitempty"`
+	Editable        bool   `json:"editable,omitempty"`
+
+	URLPrivate         string `json:"url_private,omitempty"`
+	URLPrivateDownload string `json:"url_private_download,omitempty"`
+	Permalink          string `json:"permalink,omitempty"`
+	PermalinkPublic    string `json:"permalink_public,omitempty"`
+
+	Channels      []string       `json:"channels,omitempty"`
+	Groups        []string       `json:"groups,omitempty"`
+	IMs           []string       `json:"ims,omitempty"`
+	Shares        map[string]any `json:"shares,omitempty"`
+	CommentsCount int            `json:"comments_count,omitempty"`
+
+	Extra map[string]any `json:"-"`
+}
+
+func (f *File) UnmarshalJSON(b []byte) error {
+	type file File
+	extra, err := unmarshalWithExtra(b, (*file)(f))
+	f.Extra = extra
+	return err
+}
+
+func (f File) MarshalJSON() ([]byte, error) {
+	type file File
+	return marshalWithExtra(file(f), f.Extra)
+}
+
+// Team is a Slack workspace object. Fields which aren't
+// defined explicitly in this struct are preserved in Extra.
+//
+// https://docs.slack.dev/reference/methods/team.info
+type Team struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Domain      string `json:"domain,omitempty"`
+	EmailDomain string `json:"email_domain,omitempty"`
+	URL         string `json:"url,omitempty"`
+
+	Icon map[string]any `json:"icon,omitempty"`
+
+	EnterpriseID     string `json:"enterprise_id,omitempty"`
+	EnterpriseName   string `json:"enterprise_name,omitempty"`
+	EnterpriseDomain string `json:"enterprise_domain,omitempty"`
+	IsVerified       bool   `json:"is_verified,omitempty"`
+
+	Extra map[string]any `json:"-"`
+}
+
+func (t *Team) UnmarshalJSON(b []byte) error {
+	type team Team
+	extra, err := unmarshalWithExtra(b, (*team)(t))
+	t.Extra = extra
+	return err
+}
+
+func (t Team) MarshalJSON() ([]byte, error) {
+	type team Team
+	return marshalWithExtra(team(t), t.Extra)
+}
+
+// unmarshalWithExtra decodes a JSON object into v (a pointer to a struct without
+// custom JSON methods), and returns all the object's fields which don't correspond
+// to the struct's JSON field names. It returns a nil map if there aren't any.
+func unmarshalWithExtra(b []byte, v any) (map[string]any, error) {
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+
+	var extra map[string]any
+	if err := json.Unmarshal(b, &extra); err != nil {
+		return nil, err
+	}
+
+	for _, name := range jsonFieldNames(reflect.TypeOf(v).Elem()) {
+		delete(extra, name)
+	}
+	if len(extra) == 0 {
+		return nil, nil
+	}
+	return extra, nil
+}
+
+// marshalWithExtra encodes v (a struct without custom JSON methods) as a JSON object,
+// and adds to it the given extra fields, unless they conflict with the struct's fields.
+func marshalWithExtra(v any, extra map[string]any) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return b, err
+	}
+
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+
+	for k, v := range extra {
+		if _, ok := m[k]; ok {
+			continue
+		}
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		m[k] = raw
+	}
+
+	return json.Marshal(m)
+}
+
+var fieldNamesCache sync.Map // reflect.Type --> []string
+
+// jsonFieldNames returns the JSON field names of a struct type.
+func jsonFieldNames(t reflect.Type) []string {
+	if names, ok := fieldNamesCache.Load(t); ok {
+		return names.([]string)
+	}
+
+	var names []string
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = f.Name
+		}
+		names = append(names, name)
+	}
+
+	fieldNamesCache.Store(t, names)
+	return names
+}
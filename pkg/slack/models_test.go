@@ -0,0 +1,97 @@
+package slack
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestModelsExtraFields(t *testing.T) {
+	in := `{"channel":"C1","file":{"id":"F1","thumb_64":"url"},"message":{"blocks":[{"type":"divider"}],` +
+		`"reactions":[{"count":1,"name":"+1","users":["U1"]}],"text":"hi","ts":"1.2","x_custom":[1,2]},"type":"message"}`
+
+	item := new(ReactionItem)
+	if err := json.Unmarshal([]byte(in), item); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if item.Type != "message" || item.Channel != "C1" {
+		t.Errorf("ReactionItem = %+v", item)
+	}
+	if item.Extra != nil {
+		t.Errorf("ReactionItem.Extra = %v, want nil", item.Extra)
+	}
+	if item.Message.Text != "hi" || item.Message.Reactions[0].Users[0] != "U1" {
+		t.Errorf("Message = %+v", item.Message)
+	}
+	if want := map[string]any{"x_custom": []any{1.0, 2.0}}; !reflect.DeepEqual(item.Message.Extra, want) {
+		t.Errorf("Message.Extra = %v, want %v", item.Message.Extra, want)
+	}
+	if want := map[string]any{"thumb_64": "url"}; !reflect.DeepEqual(item.File.Extra, want) {
+		t.Errorf("File.Extra = %v, want %v", item.File.Extra, want)
+	}
+
+	out, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if !jsonEqual(t, out, []byte(in)) {
+		t.Errorf("json.Marshal() = %s, want %s", out, in)
+	}
+}
+
+func TestModelsNoExtraFields(t *testing.T) {
+	in := `{"id":"U1","name":"user","profile":{"email":"user@example.com"}}`
+
+	u := new(User)
+	if err := json.Unmarshal([]byte(in), u); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if u.Extra != nil || u.Profile.Extra != nil {
+		t.Errorf("User.Extra = %v, Profile.Extra = %v, want nil", u.Extra, u.Profile.Extra)
+	}
+
+	out, err := json.Marshal(u)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(out) != in {
+		t.Errorf("json.Marshal() = %s, want %s", out, in)
+	}
+}
+
+func TestUserGroupExtraFields(t *testing.T) {
+	in := `{"id":"S1","handle":"oncall","prefs":{"channels":["C1"]},"users":["U1","U2"],"is_subteam":true}`
+
+	g := new(UserGroup)
+	if err := json.Unmarshal([]byte(in), g); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if g.Handle != "oncall" || len(g.Users) != 2 || g.Prefs.Channels[0] != "C1" {
+		t.Errorf("UserGroup = %+v", g)
+	}
+	if want := map[string]any{"is_subteam": true}; !reflect.DeepEqual(g.Extra, want) {
+		t.Errorf("UserGroup.Extra = %v, want %v", g.Extra, want)
+	}
+
+	out, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if !jsonEqual(t, out, []byte(in)) {
+		t.Errorf("json.Marshal() = %s, want %s", out, in)
+	}
+}
+
+func jsonEqual(t *testing.T, a, b []byte) bool {
+	t.Helper()
+
+	var x, y any
+	if err := json.Unmarshal(a, &x); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if err := json.Unmarshal(b, &y); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	return reflect.DeepEqual(x, y)
+}
@@ -0,0 +1,187 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.temporal.io/sdk/temporal"
+
+	"github.com/tzrikka/ovid/pkg/client"
+)
+
+// TokenType selects which credential in the Thrippy link is
+// used to authenticate a specific Slack API call.
+type TokenType string
+
+const (
+	BotToken  TokenType = "bot"  // "bot_token", or the OAuth "access_token" (default).
+	UserToken TokenType = "user" // "user_token", or the OAuth "access_token" if it's a user token.
+	AppToken  TokenType = "app"  // "app_token", i.e. an app-level token.
+	OrgToken  TokenType = "org"  // "org_token", or else the user token (for "admin.*" methods).
+)
+
+// methodTokens maps Ovid activity names to the token type that they use by default,
+// if it isn't [BotToken]. Some Slack API methods don't support bot tokens at all.
+var methodTokens = map[string]TokenType{
+	AdminConversationsArchiveName:     OrgToken,
+	AdminConversationsCreateName:      OrgToken,
+	AdminConversationsSearchName:      OrgToken,
+	AdminConversationsSetTeamsName:    OrgToken,
+	AdminTeamsListName:                OrgToken,
+	AdminUsergroupsAddChannelsName:    OrgToken,
+	AdminUsergroupsAddTeamsName:       OrgToken,
+	AdminUsergroupsListChannelsName:   OrgToken,
+	AdminUsergroupsRemoveChannelsName: OrgToken,
+	AdminUsersAssignName:              OrgToken,
+	AdminUsersInviteName:              OrgToken,
+	AdminUsersRemoveName:              OrgToken,
+	AdminUsersSetAdminName:            OrgToken,
+
+	DndEndDndName:    UserToken,
+	DndEndSnoozeName: UserToken,
+	DndSetSnoozeName: UserToken,
+
+	RemindersAddName:      UserToken,
+	RemindersCompleteName: UserToken,
+	RemindersDeleteName:   UserToken,
+	RemindersInfoName:     UserToken,
+	RemindersListName:     UserToken,
+
+	SearchAllName:      UserToken,
+	SearchFilesName:    UserToken,
+	SearchMessagesName: UserToken,
+
+	UsersIdentityName:    UserToken,
+	UsersProfileSetName:  UserToken,
+	UsersSetPresenceName: UserToken,
+}
+
+// CallOptions control how Ovid calls the Slack API, per activity invocation.
+// They are embedded in all activity requests, but aren't sent to Slack.
+type CallOptions struct {
+	// Link is the name (in the worker's configuration) or the ID of
+	// the Thrippy link of a specific Slack workspace. By default, Ovid
+	// uses the worker's default link.
+	Link string `json:"ovid_link,omitempty"`
+
+	// Token overrides the default token type of the Slack API method.
+	Token TokenType `json:"ovid_token,omitempty"`
+}
+
+const callOptionsPrefix = "ovid_"
+
+func (o CallOptions) callOptions() CallOptions {
+	return o
+}
+
+type callOptionsKey struct{}
+
+// withCallOptions returns a copy of the context with the [CallOptions] of an activity
+// request, if it has any, so they apply to all the Slack API calls of that activity.
+func withCallOptions(ctx context.Context, req any) context.Context {
+	o := callOptionsOf(req)
+	if o == (CallOptions{}) {
+		return ctx
+	}
+	return context.WithValue(ctx, callOptionsKey{}, o)
+}
+
+func callOptionsOf(req any) CallOptions {
+	r, ok := req.(interface{ callOptions() CallOptions })
+	if !ok {
+		return CallOptions{}
+	}
+	if v := reflect.ValueOf(req); v.Kind() == reflect.Pointer && v.IsNil() {
+		return CallOptions{}
+	}
+	return r.callOptions()
+}
+
+// tokenType returns the token type of a Slack API call: the one in the context's
+// [CallOptions] if there is one, or else the default of the Slack API method.
+func tokenType(ctx context.Context, method string) TokenType {
+	if o, ok := ctx.Value(callOptionsKey{}).(CallOptions); ok && o.Token != "" {
+		return o.Token
+	}
+	if t, ok := methodTokens[method]; ok {
+		return t
+	}
+	return BotToken
+}
+
+func init() {
+	client.RegisterLinkAuthenticator("slack", linkAuthenticator)
+}
+
+// linkAuthenticator is the [client.LinkAuthFunc] of all Slack Thrippy link templates.
+// The kind is a [TokenType], and it defaults to [BotToken].
+func linkAuthenticator(secrets map[string]string, kind string) (client.Authenticator, error) {
+	t := TokenType(kind)
+	if t == "" {
+		t = BotToken
+	}
+
+	tok := token(secrets, t)
+	if tok == "" {
+		msg := fmt.Sprintf("Slack %s token not found in Thrippy link credentials", t)
+		return nil, temporal.NewNonRetryableApplicationError(msg, "error", nil)
+	}
+	return client.BearerToken(tok), nil
+}
+
+// token returns the credential of the given type from the secrets of a Thrippy link,
+// or an empty string if it's not there. Thrippy links based on Slack OAuth templates
+// store a single "access_token", which is used as a user token only if it is one.
+func token(secrets map[string]string, t TokenType) string {
+	oauth := secrets["access_token"]
+	switch t {
+	case BotToken:
+		if secrets["bot_token"] != "" {
+			return secrets["bot_token"]
+		}
+		return oauth // Possibly short-lived.
+	case OrgToken:
+		if secrets["org_token"] != "" {
+			return secrets["org_token"]
+		}
+		fallthrough // Org-level installations of Enterprise Grid apps.
+	case UserToken:
+		if secrets["user_token"] != "" {
+			return secrets["user_token"]
+		}
+		if strings.HasPrefix(oauth, "xoxp-") {
+			return oauth
+		}
+	case AppToken:
+		return secrets["app_token"]
+	}
+	return ""
+}
+
+// stripCallOptions returns the JSON body of a Slack API request without
+// its [CallOptions], because Slack doesn't expect unknown fields.
+func stripCallOptions(jsonBody any) (any, error) {
+	if callOptionsOf(jsonBody) == (CallOptions{}) {
+		return jsonBody, nil // Omitted anyway.
+	}
+
+	b, err := json.Marshal(jsonBody)
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	for k := range m {
+		if strings.HasPrefix(k, callOptionsPrefix) {
+			delete(m, k)
+		}
+	}
+
+	return m, nil
+}
@@ -0,0 +1,213 @@
+package slack
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tzrikka/ovid/pkg/client"
+)
+
+func TestTokenType(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    any
+		method string
+		want   TokenType
+	}{
+		{
+			name:   "bot_default",
+			req:    &ChatPostMessageRequest{},
+			method: ChatPostMessageName,
+			want:   BotToken,
+		},
+		{
+			name:   "method_default",
+			req:    &SearchMessagesRequest{},
+			method: SearchMessagesName,
+			want:   UserToken,
+		},
+		{
+			name:   "admin_method",
+			req:    &AdminUsersInviteRequest{},
+			method: AdminUsersInviteName,
+			want:   OrgToken,
+		},
+		{
+			name:   "override",
+			req:    &ChatPostMessageRequest{CallOptions: CallOptions{Token: UserToken}},
+			method: ChatPostMessageName,
+			want:   UserToken,
+		},
+		{
+			name:   "embedded_override",
+			req:    &SearchMessagesAllRequest{SearchMessagesRequest: SearchMessagesRequest{CallOptions: CallOptions{Token: BotToken}}},
+			method: SearchMessagesName,
+			want:   BotToken,
+		},
+		{
+			name:   "nil_request",
+			req:    (*ChatPostMessageRequest)(nil),
+			method: ChatPostMessageName,
+			want:   BotToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := withCallOptions(t.Context(), tt.req)
+			if got := tokenType(ctx, tt.method); got != tt.want {
+				t.Errorf("tokenType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		secrets map[string]string
+		t       TokenType
+		want    string
+	}{
+		{
+			name:    "bot_token",
+			secrets: map[string]string{"bot_token": "xoxb-1", "access_token": "xoxb-2"},
+			t:       BotToken,
+			want:    "xoxb-1",
+		},
+		{
+			name:    "bot_oauth",
+			secrets: map[string]string{"access_token": "xoxb-2"},
+			t:       BotToken,
+			want:    "xoxb-2",
+		},
+		{
+			name:    "user_token",
+			secrets: map[string]string{"bot_token": "xoxb-1", "user_token": "xoxp-1"},
+			t:       UserToken,
+			want:    "xoxp-1",
+		},
+		{
+			name:    "user_oauth",
+			secrets: map[string]string{"access_token": "xoxp-2"},
+			t:       UserToken,
+			want:    "xoxp-2",
+		},
+		{
+			name:    "user_missing",
+			secrets: map[string]string{"access_token": "xoxb-2"},
+			t:       UserToken,
+		},
+		{
+			name:    "org_token",
+			secrets: map[string]string{"org_token": "xoxp-1", "user_token": "xoxp-2"},
+			t:       OrgToken,
+			want:    "xoxp-1",
+		},
+		{
+			name:    "org_user_token",
+			secrets: map[string]string{"access_token": "xoxp-2"},
+			t:       OrgToken,
+			want:    "xoxp-2",
+		},
+		{
+			name:    "app_token",
+			secrets: map[string]string{"bot_token": "xoxb-1", "app_token": "xapp-1"},
+			t:       AppToken,
+			want:    "xapp-1",
+		},
+		{
+			name:    "invalid_type",
+			secrets: map[string]string{"bot_token": "xoxb-1"},
+			t:       "foo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := token(tt.secrets, tt.t); got != tt.want {
+				t.Errorf("token() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinkAuthenticator(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		secrets  map[string]string
+		kind     TokenType
+		want     client.Authenticator
+		wantErr  bool
+	}{
+		{
+			name:     "default_bot_token",
+			template: "slack-bot-token",
+			secrets:  map[string]string{"bot_token": "xoxb-1", "user_token": "xoxp-1"},
+			want:     client.BearerToken("xoxb-1"),
+		},
+		{
+			name:     "user_token",
+			template: "slack-oauth",
+			secrets:  map[string]string{"bot_token": "xoxb-1", "user_token": "xoxp-1"},
+			kind:     UserToken,
+			want:     client.BearerToken("xoxp-1"),
+		},
+		{
+			name:     "gov_oauth",
+			template: "slack-oauth-gov",
+			secrets:  map[string]string{"access_token": "xoxb-2"},
+			kind:     BotToken,
+			want:     client.BearerToken("xoxb-2"),
+		},
+		{
+			name:     "missing_token",
+			template: "slack-socket-mode",
+			secrets:  map[string]string{"bot_token": "xoxb-1"},
+			kind:     AppToken,
+			wantErr:  true,
+		},
+		{
+			name:     "unknown_template",
+			template: "github-app-jwt",
+			secrets:  map[string]string{"bot_token": "xoxb-1"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.LinkAuthenticator(tt.template, tt.secrets, string(tt.kind))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LinkAuthenticator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("LinkAuthenticator() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripCallOptions(t *testing.T) {
+	req := &ChatPostMessageRequest{CallOptions: CallOptions{Token: UserToken}, Channel: "C1", Text: "hi"}
+	body, err := stripCallOptions(req)
+	if err != nil {
+		t.Fatalf("stripCallOptions() error = %v", err)
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]any{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]any{"channel": "C1", "text": "hi"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("stripCallOptions() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,105 @@
+package slack
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/activity"
+)
+
+const (
+	// DefaultMaxItems is the default cap on the number of items that "all"
+	// activities (e.g. [API.ConversationsListAllActivity]) return, if their
+	// request doesn't specify "max_items", to keep their results and their
+	// heartbeat details (which also contain the items, see [paginate]) well
+	// under Temporal's payload size limit. If an "all" activity stops because
+	// of this cap, the "next_cursor" in its response points to the remaining
+	// pages.
+	DefaultMaxItems = 200
+)
+
+// pageProgress is the heartbeat details of "all" activities, which
+// allows them to resume from the last fetched page after a retry.
+type pageProgress[T any] struct {
+	Cursor string `json:"cursor,omitempty"`
+	Items  []T    `json:"items,omitempty"`
+}
+
+// pageFunc fetches a single page from a cursor-based Slack API method,
+// and returns its items and the cursor of the next page (if there is one).
+type pageFunc[T any] func(ctx context.Context, cursor string) ([]T, string, error)
+
+// paginate calls a cursor-based Slack API method repeatedly, starting from the
+// given cursor, until there are no more pages, or until it has collected at
+// least maxItems (or [DefaultMaxItems] if it's not positive). In the latter
+// case, it also returns the cursor of the next page, so the caller can continue
+// later. It records its progress in heartbeats, and resumes from the last one.
+// Heartbeats include all the items so far, so their size grows with each page,
+// which is why maxItems should stay small.
+func paginate[T any](ctx context.Context, cursor string, maxItems int, page pageFunc[T]) ([]T, string, error) {
+	if maxItems <= 0 {
+		maxItems = DefaultMaxItems
+	}
+
+	p := &pageProgress[T]{Cursor: cursor}
+	if activity.IsActivity(ctx) && activity.HasHeartbeatDetails(ctx) {
+		// Empty cursors are omitted from heartbeat details, so they must not be
+		// decoded on top of the initial one, which may be different.
+		resumed := &pageProgress[T]{}
+		if err := activity.GetHeartbeatDetails(ctx, resumed); err != nil {
+			activity.GetLogger(ctx).Warn("failed to resume pagination from heartbeat details", "error", err.Error())
+		} else {
+			p = resumed
+		}
+	}
+
+	// A previous attempt already fetched the last page, or reached the
+	// cap, but failed before returning: don't fetch any pages again.
+	if len(p.Items) > 0 && (p.Cursor == "" || len(p.Items) >= maxItems) {
+		return p.Items, p.Cursor, nil
+	}
+
+	ctx = context.WithValue(ctx, heartbeatDetailsKey{}, p)
+	for {
+		items, next, err := page(ctx, p.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		p.Items = append(p.Items, items...)
+		p.Cursor = next
+		heartbeat(ctx)
+
+		if next == "" {
+			return p.Items, "", nil
+		}
+		if len(p.Items) >= maxItems {
+			return p.Items, next, nil
+		}
+	}
+}
+
+type heartbeatDetailsKey struct{}
+
+// heartbeat records a Temporal activity heartbeat, if the context belongs to
+// an activity. If the activity is paginating, the heartbeat also includes its
+// current progress, so heartbeats from other places don't overwrite it.
+func heartbeat(ctx context.Context) {
+	if !activity.IsActivity(ctx) {
+		return
+	}
+
+	if details := ctx.Value(heartbeatDetailsKey{}); details != nil {
+		activity.RecordHeartbeat(ctx, details)
+		return
+	}
+
+	activity.RecordHeartbeat(ctx)
+}
+
+// nextCursor returns the cursor of the next page in a Slack API response, if there is one.
+func (r *slackResponse) nextCursor() string {
+	if r.ResponseMetadata == nil {
+		return ""
+	}
+	return r.ResponseMetadata.NextCursor
+}
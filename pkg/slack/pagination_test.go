@@ -0,0 +1,211 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestPaginate(t *testing.T) {
+	// 5 pages with 2 items each: cursors "", "1", "2", "3", "4".
+	pages := func(ctx context.Context, cursor string) ([]string, string, error) {
+		i := 0
+		if cursor != "" {
+			i, _ = strconv.Atoi(cursor)
+		}
+		next := strconv.Itoa(i + 1)
+		if i == 4 {
+			next = ""
+		}
+		return []string{cursor + "a", cursor + "b"}, next, nil
+	}
+
+	tests := []struct {
+		name      string
+		cursor    string
+		maxItems  int
+		wantItems []string
+		wantNext  string
+	}{
+		{
+			name:      "all_pages",
+			wantItems: []string{"a", "b", "1a", "1b", "2a", "2b", "3a", "3b", "4a", "4b"},
+		},
+		{
+			name:      "from_cursor",
+			cursor:    "3",
+			wantItems: []string{"3a", "3b", "4a", "4b"},
+		},
+		{
+			name:      "max_items",
+			maxItems:  3,
+			wantItems: []string{"a", "b", "1a", "1b"},
+			wantNext:  "2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotItems, gotNext, err := paginate(t.Context(), tt.cursor, tt.maxItems, pages)
+			if err != nil {
+				t.Fatalf("paginate() error = %v", err)
+			}
+			if !reflect.DeepEqual(gotItems, tt.wantItems) {
+				t.Errorf("paginate() items = %v, want %v", gotItems, tt.wantItems)
+			}
+			if gotNext != tt.wantNext {
+				t.Errorf("paginate() next cursor = %q, want %q", gotNext, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestPaginateError(t *testing.T) {
+	want := errors.New("error")
+	_, _, err := paginate(t.Context(), "", 0, func(ctx context.Context, cursor string) ([]string, string, error) {
+		if cursor == "" {
+			return []string{"a"}, "1", nil
+		}
+		return nil, "", want
+	})
+	if !errors.Is(err, want) {
+		t.Errorf("paginate() error = %v, want %v", err, want)
+	}
+}
+
+func TestPaginateResume(t *testing.T) {
+	tests := []struct {
+		name      string
+		details   pageProgress[string]
+		maxItems  int
+		wantItems []string
+		wantNext  string
+		wantCalls []string
+	}{
+		{
+			name:      "from_heartbeat_cursor",
+			details:   pageProgress[string]{Cursor: "1", Items: []string{"a"}},
+			wantItems: []string{"a", "1a"},
+			wantCalls: []string{"1"},
+		},
+		{
+			name:      "after_last_page",
+			details:   pageProgress[string]{Items: []string{"a", "1a"}},
+			wantItems: []string{"a", "1a"},
+		},
+		{
+			name:      "after_max_items",
+			details:   pageProgress[string]{Cursor: "1", Items: []string{"a"}},
+			maxItems:  1,
+			wantItems: []string{"a"},
+			wantNext:  "1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			pages := func(ctx context.Context, cursor string) ([]string, string, error) {
+				calls = append(calls, cursor)
+				return []string{cursor + "a"}, "", nil
+			}
+			f := func(ctx context.Context) (*pageProgress[string], error) {
+				items, next, err := paginate(ctx, "2", tt.maxItems, pages)
+				return &pageProgress[string]{Cursor: next, Items: items}, err
+			}
+
+			ts := &testsuite.WorkflowTestSuite{}
+			env := ts.NewTestActivityEnvironment()
+			env.RegisterActivityWithOptions(f, activity.RegisterOptions{Name: "paginate"})
+			env.SetHeartbeatDetails(tt.details)
+
+			v, err := env.ExecuteActivity("paginate")
+			if err != nil {
+				t.Fatalf("paginate() error = %v", err)
+			}
+			got := new(pageProgress[string])
+			if err := v.Get(got); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got.Items, tt.wantItems) {
+				t.Errorf("paginate() items = %v, want %v", got.Items, tt.wantItems)
+			}
+			if got.Cursor != tt.wantNext {
+				t.Errorf("paginate() next cursor = %q, want %q", got.Cursor, tt.wantNext)
+			}
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("paginate() fetched pages %q, want %q", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestPaginateSearch(t *testing.T) {
+	// 3 pages with 2 items each.
+	pages := func(ctx context.Context, page int) ([]int, int, error) {
+		next := page + 1
+		if page == 3 {
+			next = 0
+		}
+		return []int{page*10 + 1, page*10 + 2}, next, nil
+	}
+
+	items, next, err := paginateSearch(t.Context(), 0, 0, pages)
+	if err != nil {
+		t.Fatalf("paginateSearch() error = %v", err)
+	}
+	if want := []int{11, 12, 21, 22, 31, 32}; !reflect.DeepEqual(items, want) || next != 0 {
+		t.Errorf("paginateSearch() = %v, %d, want %v, 0", items, next, want)
+	}
+
+	items, next, err = paginateSearch(t.Context(), 2, 1, pages)
+	if err != nil {
+		t.Fatalf("paginateSearch() error = %v", err)
+	}
+	if want := []int{21, 22}; !reflect.DeepEqual(items, want) || next != 3 {
+		t.Errorf("paginateSearch() = %v, %d, want %v, 3", items, next, want)
+	}
+}
+
+func TestPaginateSearchCursors(t *testing.T) {
+	f := func(ctx context.Context, page int) ([]int, int, error) {
+		t.Errorf("unexpected page request: %d", page)
+		return nil, 0, nil
+	}
+
+	// Resuming after the last page (see [paginate]).
+	ts := &testsuite.WorkflowTestSuite{}
+	env := ts.NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(func(ctx context.Context) ([]int, error) {
+		items, _, err := paginateSearch(ctx, 1, 0, f)
+		return items, err
+	}, activity.RegisterOptions{Name: "search"})
+	env.SetHeartbeatDetails(pageProgress[int]{Items: []int{11, 12}})
+
+	v, err := env.ExecuteActivity("search")
+	if err != nil {
+		t.Fatalf("paginateSearch() error = %v", err)
+	}
+	var items []int
+	if err := v.Get(&items); err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{11, 12}; !reflect.DeepEqual(items, want) {
+		t.Errorf("paginateSearch() = %v, want %v", items, want)
+	}
+
+	// Invalid cursors.
+	var appErr *temporal.ApplicationError
+	for _, cursor := range []string{"x", "0"} {
+		if _, err := searchPage(cursor); !errors.As(err, &appErr) || !appErr.NonRetryable() {
+			t.Errorf("searchPage(%q) error = %v, want non-retryable error", cursor, err)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+)
+
+const (
+	PinsAddName    = "slack.pins.add"
+	PinsListName   = "slack.pins.list"
+	PinsRemoveName = "slack.pins.remove"
+)
+
+// https://docs.slack.dev/reference/methods/pins.add
+type PinsAddRequest struct {
+	CallOptions
+
+	Channel   string `json:"channel"`
+	Timestamp string `json:"timestamp"`
+}
+
+// https://docs.slack.dev/reference/methods/pins.add
+type PinsAddResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/pins.add
+func (a *API) PinsAddActivity(ctx context.Context, req *PinsAddRequest) (*PinsAddResponse, error) {
+	resp := new(PinsAddResponse)
+	if err := a.httpPost(ctx, PinsAddName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/pins.list
+type PinsListRequest struct {
+	CallOptions
+
+	Channel string `json:"channel"`
+}
+
+// https://docs.slack.dev/reference/methods/pins.list
+type PinsListResponse struct {
+	slackResponse
+
+	Items []PinnedItem `json:"items,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/pins.list
+type PinnedItem struct {
+	Type      string   `json:"type"`
+	Channel   string   `json:"channel,omitempty"`
+	Created   int64    `json:"created,omitempty"`
+	CreatedBy string   `json:"created_by,omitempty"`
+	Message   *Message `json:"message,omitempty"`
+	File      *File    `json:"file,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/pins.list
+func (a *API) PinsListActivity(ctx context.Context, req *PinsListRequest) (*PinsListResponse, error) {
+	query := url.Values{}
+	query.Set("channel", req.Channel)
+
+	resp := new(PinsListResponse)
+	if err := a.httpGet(ctx, PinsListName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/pins.remove
+type PinsRemoveRequest struct {
+	CallOptions
+
+	Channel   string `json:"channel"`
+	Timestamp string `json:"timestamp"`
+}
+
+// https://docs.slack.dev/reference/methods/pins.remove
+type PinsRemoveResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/pins.remove
+func (a *API) PinsRemoveActivity(ctx context.Context, req *PinsRemoveRequest) (*PinsRemoveResponse, error) {
+	resp := new(PinsRemoveResponse)
+	if err := a.httpPost(ctx, PinsRemoveName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
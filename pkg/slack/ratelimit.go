@@ -0,0 +1,350 @@
+package slack
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	altsrc "github.com/urfave/cli-altsrc/v3"
+	"github.com/urfave/cli-altsrc/v3/toml"
+	"github.com/urfave/cli/v3"
+	"golang.org/x/time/rate"
+
+	"github.com/tzrikka/ovid/internal/logger"
+)
+
+// tier identifies a published Slack API rate limit tier:
+// https://docs.slack.dev/apis/web-api/rate-limits
+type tier int
+
+const (
+	tier1 tier = iota + 1
+	tier2
+	tier3
+	tier4
+	tierPostMessage // Special tier: 1 message per second per channel.
+)
+
+const (
+	// defaultTier applies to Slack API methods which aren't listed in [methodTiers].
+	defaultTier = tier3
+
+	heartbeatInterval = time.Second
+
+	// limiterIdleTTL is how long token buckets may stay unused before they're
+	// removed, if they're also full (i.e. indistinguishable from new ones).
+	limiterIdleTTL = 10 * time.Minute
+	sweepInterval  = time.Minute
+)
+
+// methodTiers maps Ovid activity names (which also encode the Slack API
+// method names) to their documented Slack API rate limit tiers.
+var methodTiers = map[string]tier{
+	AdminConversationsArchiveName:     tier2,
+	AdminConversationsCreateName:      tier2,
+	AdminConversationsSearchName:      tier2,
+	AdminConversationsSetTeamsName:    tier2,
+	AdminTeamsListName:                tier2,
+	AdminUsergroupsAddChannelsName:    tier2,
+	AdminUsergroupsAddTeamsName:       tier2,
+	AdminUsergroupsListChannelsName:   tier2,
+	AdminUsergroupsRemoveChannelsName: tier2,
+	AdminUsersAssignName:              tier2,
+	AdminUsersInviteName:              tier2,
+	AdminUsersRemoveName:              tier2,
+	AdminUsersSetAdminName:            tier2,
+
+	AuthTestName: tier4, // Special tier, higher than Tier 4.
+
+	BookmarksAddName:    tier2,
+	BookmarksEditName:   tier2,
+	BookmarksListName:   tier3,
+	BookmarksRemoveName: tier2,
+
+	BotsInfoName: tier3,
+
+	CanvasesAccessDeleteName:   tier3,
+	CanvasesAccessSetName:      tier3,
+	CanvasesCreateName:         tier2,
+	CanvasesDeleteName:         tier3,
+	CanvasesEditName:           tier3,
+	CanvasesSectionsLookupName: tier3,
+
+	ChatDeleteName:                 tier3,
+	ChatDeleteScheduledMessageName: tier3,
+	ChatGetPermalinkName:           tier4,
+	ChatMeMessageName:              tier3,
+	ChatPostEphemeralName:          tier4,
+	ChatPostMessageName:            tierPostMessage,
+	ChatScheduleMessageName:        tier3,
+	ChatScheduledMessagesListName:  tier3,
+	ChatUnfurlName:                 tier3,
+	ChatUpdateName:                 tier3,
+
+	ConversationsArchiveName:        tier2,
+	ConversationsCanvasesCreateName: tier2,
+	ConversationsCloseName:          tier2,
+	ConversationsCreateName:         tier2,
+	ConversationsHistoryName:        tier3,
+	ConversationsInfoName:           tier3,
+	ConversationsInviteName:         tier3,
+	ConversationsJoinName:           tier3,
+	ConversationsKickName:           tier3,
+	ConversationsLeaveName:          tier3,
+	ConversationsListName:           tier2,
+	ConversationsMembersName:        tier4,
+	ConversationsOpenName:           tier3,
+	ConversationsRenameName:         tier2,
+	ConversationsRepliesName:        tier3,
+	ConversationsSetPurposeName:     tier2,
+	ConversationsSetTopicName:       tier2,
+	ConversationsUnarchiveName:      tier2,
+
+	DndEndDndName:    tier2,
+	DndEndSnoozeName: tier2,
+	DndInfoName:      tier3,
+	DndSetSnoozeName: tier2,
+	DndTeamInfoName:  tier2,
+
+	EmojiListName: tier2,
+
+	FilesCompleteUploadExternalName: tier4,
+	FilesDeleteName:                 tier3,
+	FilesGetUploadURLExternalName:   tier4,
+	FilesInfoName:                   tier4,
+	FilesListName:                   tier3,
+	FilesSharedPublicURLName:        tier3,
+
+	PinsAddName:    tier2,
+	PinsListName:   tier2,
+	PinsRemoveName: tier2,
+
+	ReactionsAddName:    tier3,
+	ReactionsGetName:    tier3,
+	ReactionsListName:   tier2,
+	ReactionsRemoveName: tier2,
+
+	RemindersAddName:      tier2,
+	RemindersCompleteName: tier2,
+	RemindersDeleteName:   tier2,
+	RemindersInfoName:     tier2,
+	RemindersListName:     tier2,
+
+	SearchAllName:      tier2,
+	SearchFilesName:    tier2,
+	SearchMessagesName: tier2,
+
+	TeamInfoName:       tier3,
+	TeamProfileGetName: tier3,
+
+	UsergroupsCreateName:      tier2,
+	UsergroupsDisableName:     tier2,
+	UsergroupsEnableName:      tier2,
+	UsergroupsListName:        tier2,
+	UsergroupsUpdateName:      tier2,
+	UsergroupsUsersListName:   tier2,
+	UsergroupsUsersUpdateName: tier2,
+
+	UsersConversationsName: tier3,
+	UsersGetPresenceName:   tier3,
+	UsersIdentityName:      tier4,
+	UsersInfoName:          tier4,
+	UsersListName:          tier2,
+	UsersLookupByEmailName: tier3,
+	UsersProfileGetName:    tier4,
+	UsersProfileSetName:    tier3,
+	UsersSetPresenceName:   tier2,
+
+	ViewsOpenName:    tier4,
+	ViewsPublishName: tier4,
+	ViewsPushName:    tier4,
+	ViewsUpdateName:  tier4,
+}
+
+// RateLimitFlags defines CLI flags to override the default Slack API rate limits
+// that Ovid enforces on the client side. These flags can also be set using
+// environment variables and the application's configuration file.
+// Non-positive values disable client-side rate limiting for the respective tier.
+func RateLimitFlags(configFilePath altsrc.StringSourcer) []cli.Flag {
+	return []cli.Flag{
+		&cli.IntFlag{
+			Name:  "slack-rate-limit-tier1",
+			Usage: "Slack API requests per minute, per method and link, in Tier 1",
+			Value: 1,
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("SLACK_RATE_LIMIT_TIER1"),
+				toml.TOML("slack.rate_limits.tier1", configFilePath),
+			),
+		},
+		&cli.IntFlag{
+			Name:  "slack-rate-limit-tier2",
+			Usage: "Slack API requests per minute, per method and link, in Tier 2",
+			Value: 20,
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("SLACK_RATE_LIMIT_TIER2"),
+				toml.TOML("slack.rate_limits.tier2", configFilePath),
+			),
+		},
+		&cli.IntFlag{
+			Name:  "slack-rate-limit-tier3",
+			Usage: "Slack API requests per minute, per method and link, in Tier 3",
+			Value: 50,
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("SLACK_RATE_LIMIT_TIER3"),
+				toml.TOML("slack.rate_limits.tier3", configFilePath),
+			),
+		},
+		&cli.IntFlag{
+			Name:  "slack-rate-limit-tier4",
+			Usage: "Slack API requests per minute, per method and link, in Tier 4",
+			Value: 100,
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("SLACK_RATE_LIMIT_TIER4"),
+				toml.TOML("slack.rate_limits.tier4", configFilePath),
+			),
+		},
+		&cli.IntFlag{
+			Name:  "slack-rate-limit-post-message",
+			Usage: "Slack chat.postMessage requests per second, per channel and link",
+			Value: 1,
+			Sources: cli.NewValueSourceChain(
+				cli.EnvVar("SLACK_RATE_LIMIT_POST_MESSAGE"),
+				toml.TOML("slack.rate_limits.post_message", configFilePath),
+			),
+		},
+	}
+}
+
+// rateLimiter paces outgoing Slack API requests on the client side, to avoid
+// HTTP 429 responses. It maintains a separate token bucket for each combination
+// of Thrippy link ID and Slack API method (and channel, in the special case of
+// "chat.postMessage"), with a burst size of 1. Idle buckets are removed
+// periodically, so the number of channels doesn't grow memory usage forever.
+type rateLimiter struct {
+	limits map[tier]rate.Limit
+	now    func() time.Time
+
+	mu        sync.Mutex
+	limiters  map[string]*limiterEntry
+	lastSweep time.Time
+}
+
+type limiterEntry struct {
+	*rate.Limiter
+	lastUsed time.Time
+}
+
+func newRateLimiter(cmd *cli.Command) *rateLimiter {
+	perMinute := func(n int) rate.Limit {
+		if n <= 0 {
+			return rate.Inf
+		}
+		return rate.Every(time.Minute / time.Duration(n))
+	}
+
+	perSecond := func(n int) rate.Limit {
+		if n <= 0 {
+			return rate.Inf
+		}
+		return rate.Limit(n)
+	}
+
+	return &rateLimiter{
+		limits: map[tier]rate.Limit{
+			tier1:           perMinute(cmd.Int("slack-rate-limit-tier1")),
+			tier2:           perMinute(cmd.Int("slack-rate-limit-tier2")),
+			tier3:           perMinute(cmd.Int("slack-rate-limit-tier3")),
+			tier4:           perMinute(cmd.Int("slack-rate-limit-tier4")),
+			tierPostMessage: perSecond(cmd.Int("slack-rate-limit-post-message")),
+		},
+		now:      time.Now,
+		limiters: map[string]*limiterEntry{},
+	}
+}
+
+// limiter returns the token bucket of a specific link, method, and channel (which
+// is ignored unless the method is "chat.postMessage"), and initializes it if needed.
+func (r *rateLimiter) limiter(linkID, method, channel string) *rate.Limiter {
+	t, ok := methodTiers[method]
+	if !ok {
+		t = defaultTier
+	}
+
+	key := linkID + "/" + method
+	if t == tierPostMessage {
+		key += "/" + channel
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if now.Sub(r.lastSweep) >= sweepInterval {
+		r.sweep(now)
+	}
+
+	e, ok := r.limiters[key]
+	if !ok {
+		e = &limiterEntry{Limiter: rate.NewLimiter(r.limits[t], 1)}
+		r.limiters[key] = e
+	}
+	e.lastUsed = now
+	return e.Limiter
+}
+
+// sweep removes token buckets which weren't used recently, and are full.
+// Buckets with pending reservations are kept, to preserve their delays.
+// The caller must hold the mutex.
+func (r *rateLimiter) sweep(now time.Time) {
+	for k, e := range r.limiters {
+		if now.Sub(e.lastUsed) >= limiterIdleTTL && e.TokensAt(now) >= 1 {
+			delete(r.limiters, k)
+		}
+	}
+	r.lastSweep = now
+}
+
+// wait blocks until the rate limit of a specific link, method, and channel (which
+// is ignored unless the method is "chat.postMessage") allows another request.
+// While waiting, it sends heartbeats if it's running in a Temporal activity.
+// It returns an error only if the context is canceled before the wait is over.
+func (r *rateLimiter) wait(ctx context.Context, linkID, method, channel string) error {
+	if r == nil {
+		return nil
+	}
+
+	res := r.limiter(linkID, method, channel).Reserve()
+	d := res.Delay()
+	if d == 0 {
+		return nil
+	}
+
+	logger.FromContext(ctx).Debug("waiting for Slack API rate limiter",
+		"link_id", linkID, "method", method, "delay", d.String())
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			res.Cancel()
+			return ctx.Err()
+		case <-ticker.C:
+			heartbeat(ctx)
+		case <-timer.C:
+			return nil
+		}
+	}
+}
+
+// postMessageChannel returns the channel ID of "chat.postMessage"
+// requests, for the special rate limit tier of that Slack API method.
+func postMessageChannel(jsonBody any) string {
+	if req, ok := jsonBody.(*ChatPostMessageRequest); ok {
+		return req.Channel
+	}
+	return ""
+}
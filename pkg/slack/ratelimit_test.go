@@ -0,0 +1,133 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func testRateLimiter(l rate.Limit) *rateLimiter {
+	return &rateLimiter{
+		limits: map[tier]rate.Limit{
+			tier1:           l,
+			tier2:           l,
+			tier3:           l,
+			tier4:           l,
+			tierPostMessage: l,
+		},
+		now:      time.Now,
+		limiters: map[string]*limiterEntry{},
+	}
+}
+
+func TestRateLimiterKeys(t *testing.T) {
+	r := testRateLimiter(rate.Every(time.Minute))
+
+	if r.limiter("link1", ChatUpdateName, "C1") != r.limiter("link1", ChatUpdateName, "C2") {
+		t.Errorf("limiter() should ignore the channel of %q", ChatUpdateName)
+	}
+	if r.limiter("link1", ChatPostMessageName, "C1") == r.limiter("link1", ChatPostMessageName, "C2") {
+		t.Errorf("limiter() should not ignore the channel of %q", ChatPostMessageName)
+	}
+	if r.limiter("link1", ChatUpdateName, "") == r.limiter("link2", ChatUpdateName, "") {
+		t.Errorf("limiter() should not share buckets between links")
+	}
+	if r.limiter("link1", ChatUpdateName, "") == r.limiter("link1", ChatDeleteName, "") {
+		t.Errorf("limiter() should not share buckets between methods")
+	}
+}
+
+func TestRateLimiterEviction(t *testing.T) {
+	r := testRateLimiter(rate.Every(time.Minute))
+	now := time.Now()
+	r.now = func() time.Time { return now }
+
+	idle := r.limiter("link", ChatPostMessageName, "C1")
+	r.limiter("link", ChatPostMessageName, "C2").Reserve()
+
+	// Recently-used buckets are kept.
+	now = now.Add(limiterIdleTTL - sweepInterval)
+	busy := r.limiter("link", ChatPostMessageName, "C3")
+	if len(r.limiters) != 3 {
+		t.Fatalf("len(limiters) = %d, want 3", len(r.limiters))
+	}
+
+	// Idle buckets are removed, and replaced by new ones when needed.
+	now = now.Add(sweepInterval)
+	if r.limiter("link", ChatPostMessageName, "C3") != busy {
+		t.Error("limiter() should keep the same bucket while it's in use")
+	}
+	if _, ok := r.limiters["link/"+ChatPostMessageName+"/C2"]; ok {
+		t.Error("limiter() should evict idle buckets")
+	}
+	if r.limiter("link", ChatPostMessageName, "C1") == idle {
+		t.Error("limiter() should replace evicted buckets")
+	}
+	if len(r.limiters) != 2 {
+		t.Errorf("len(limiters) = %d, want 2", len(r.limiters))
+	}
+}
+
+func TestRateLimiterEvictionPending(t *testing.T) {
+	r := testRateLimiter(rate.Every(time.Hour))
+	now := time.Now()
+	r.now = func() time.Time { return now }
+
+	// Buckets with pending reservations are kept, even if they're idle.
+	l := r.limiter("link", ChatUpdateName, "")
+	l.ReserveN(now, 1)
+	l.ReserveN(now, 1)
+
+	now = now.Add(limiterIdleTTL + sweepInterval)
+	r.limiter("link", ChatDeleteName, "")
+	if r.limiter("link", ChatUpdateName, "") != l {
+		t.Error("limiter() should not evict buckets with pending reservations")
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	r := testRateLimiter(rate.Every(time.Minute))
+
+	// The first request is allowed immediately.
+	if err := r.wait(t.Context(), "link", ChatUpdateName, ""); err != nil {
+		t.Fatalf("wait() error = %v", err)
+	}
+
+	// The second request is blocked until the context is canceled.
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	if err := r.wait(ctx, "link", ChatUpdateName, ""); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// Other methods are not affected.
+	if err := r.wait(t.Context(), "link", ChatDeleteName, ""); err != nil {
+		t.Errorf("wait() error = %v", err)
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	r := testRateLimiter(rate.Inf)
+	for range 10 {
+		if err := r.wait(t.Context(), "link", ChatPostMessageName, "C1"); err != nil {
+			t.Fatalf("wait() error = %v", err)
+		}
+	}
+
+	r = nil
+	if err := r.wait(t.Context(), "link", ChatPostMessageName, "C1"); err != nil {
+		t.Errorf("nil wait() error = %v", err)
+	}
+}
+
+func TestPostMessageChannel(t *testing.T) {
+	if got := postMessageChannel(&ChatPostMessageRequest{Channel: "C1"}); got != "C1" {
+		t.Errorf("postMessageChannel() = %q, want %q", got, "C1")
+	}
+	if got := postMessageChannel(&ChatUpdateRequest{Channel: "C1"}); got != "" {
+		t.Errorf("postMessageChannel() = %q, want %q", got, "")
+	}
+}
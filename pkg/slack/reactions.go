@@ -2,20 +2,22 @@ package slack
 
 import (
 	"context"
-	"errors"
 	"net/url"
 	"strconv"
 )
 
 const (
-	ReactionsAddName    = "slack.reactions.add"
-	ReactionsGetName    = "slack.reactions.get"
-	ReactionsListName   = "slack.reactions.list"
-	ReactionsRemoveName = "slack.reactions.remove"
+	ReactionsAddName     = "slack.reactions.add"
+	ReactionsGetName     = "slack.reactions.get"
+	ReactionsListName    = "slack.reactions.list"
+	ReactionsListAllName = "slack.reactions.list.all"
+	ReactionsRemoveName  = "slack.reactions.remove"
 )
 
 // https://docs.slack.dev/reference/methods/reactions.add
 type ReactionsAddRequest struct {
+	CallOptions
+
 	Channel   string `json:"channel"`
 	Name      string `json:"name"`
 	Timestamp string `json:"timestamp"`
@@ -33,13 +35,15 @@ func (a *API) ReactionsAddActivity(ctx context.Context, req *ReactionsAddRequest
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/reactions.get
 type ReactionsGetRequest struct {
+	CallOptions
+
 	Channel     string `json:"channel,omitempty"`
 	File        string `json:"file,omitempty"`
 	FileComment string `json:"file_comment,omitempty"`
@@ -51,9 +55,10 @@ type ReactionsGetRequest struct {
 type ReactionsGetResponse struct {
 	slackResponse
 
-	Type    string         `json:"type,omitempty"`
-	Message map[string]any `json:"message,omitempty"`
-	Channel string         `json:"channel,omitempty"`
+	Type    string   `json:"type,omitempty"`
+	Channel string   `json:"channel,omitempty"`
+	Message *Message `json:"message,omitempty"`
+	File    *File    `json:"file,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/reactions.get
@@ -80,13 +85,15 @@ func (a *API) ReactionsGetActivity(ctx context.Context, req *ReactionsGetRequest
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/reactions.list
 type ReactionsListRequest struct {
+	CallOptions
+
 	User   string `json:"user,omitempty"`
 	Full   bool   `json:"full,omitempty"`
 	Count  int    `json:"count,omitempty"`
@@ -100,7 +107,7 @@ type ReactionsListRequest struct {
 type ReactionsListResponse struct {
 	slackResponse
 
-	Items []map[string]any `json:"items,omitempty"`
+	Items []ReactionItem `json:"items,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/reactions.list
@@ -133,13 +140,46 @@ func (a *API) ReactionsListActivity(ctx context.Context, req *ReactionsListReque
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
+// https://docs.slack.dev/reference/methods/reactions.list
+type ReactionsListAllRequest struct {
+	ReactionsListRequest
+
+	MaxItems int `json:"max_items,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/reactions.list
+type ReactionsListAllResponse struct {
+	Items      []ReactionItem `json:"items,omitempty"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/reactions.list
+func (a *API) ReactionsListAllActivity(ctx context.Context, req *ReactionsListAllRequest) (*ReactionsListAllResponse, error) {
+	items, next, err := paginate(ctx, req.Cursor, req.MaxItems, func(ctx context.Context, cursor string) ([]ReactionItem, string, error) {
+		page := req.ReactionsListRequest
+		page.Cursor = cursor
+		resp, err := a.ReactionsListActivity(ctx, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Items, resp.nextCursor(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReactionsListAllResponse{Items: items, NextCursor: next}, nil
+}
+
 // https://docs.slack.dev/reference/methods/reactions.remove
 type ReactionsRemoveRequest struct {
+	CallOptions
+
 	Name string `json:"name"`
 
 	Channel     string `json:"channel,omitempty"`
@@ -160,7 +200,7 @@ func (a *API) ReactionsRemoveActivity(ctx context.Context, req *ReactionsRemoveR
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
@@ -1,74 +1,254 @@
 package slack
 
 import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/rs/zerolog/log"
 	altsrc "github.com/urfave/cli-altsrc/v3"
-	"github.com/urfave/cli-altsrc/v3/toml"
 	"github.com/urfave/cli/v3"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/worker"
 
 	"github.com/tzrikka/ovid/internal/thrippy"
+	"github.com/tzrikka/ovid/pkg/client"
 )
 
 type API struct {
-	thrippy thrippy.LinkClient
+	thrippy  thrippy.LinkClient            // Default link.
+	links    map[string]thrippy.LinkClient // Named links, e.g. per workspace.
+	linkData linkDataFunc                  // Overrides Thrippy, e.g. in tests.
+	baseURL  string                        // Overrides "https://slack.com", e.g. in tests.
+	client   *client.Client
+	limiter  *rateLimiter
+
+	uploadDir string // Root directory of local files in [FilesUploadRequest].
 }
 
-// LinkIDFlag defines a CLI flag for Slack's Thrippy link ID. This flag can also
-// be set using an environment variable and the application's configuration file.
+// LinkIDFlag defines a CLI flag for Slack's default Thrippy link ID. This flag can
+// also be set using an environment variable and the application's configuration
+// file (see [LinksFlag] for the format of the latter).
 func LinkIDFlag(configFilePath altsrc.StringSourcer) cli.Flag {
 	return &cli.StringFlag{
 		Name:  "thrippy-link-slack",
-		Usage: "Thrippy link ID for Slack",
+		Usage: "Thrippy link ID for Slack (default workspace)",
 		Sources: cli.NewValueSourceChain(
 			cli.EnvVar("THRIPPY_LINK_SLACK"),
-			toml.TOML("thrippy.links.slack", configFilePath),
+			linksSource{path: configFilePath},
+		),
+	}
+}
+
+// LinksFlag defines a CLI flag for named Thrippy link IDs of multiple Slack
+// workspaces, which activity requests may refer to (see [CallOptions]).
+// This flag can also be set using an environment variable ("name=id,...")
+// and the application's configuration file, where "thrippy.links.slack"
+// is either a single link ID or a table of named link IDs. In the latter
+// case, the link named "default" is also the value of [LinkIDFlag].
+func LinksFlag(configFilePath altsrc.StringSourcer) cli.Flag {
+	return &cli.StringMapFlag{
+		Name:  "thrippy-links-slack",
+		Usage: "named Thrippy link IDs for multiple Slack workspaces",
+		Sources: cli.NewValueSourceChain(
+			cli.EnvVar("THRIPPY_LINKS_SLACK"),
+			linksSource{path: configFilePath, named: true},
 		),
 	}
 }
 
 // Register exposes Temporal activities and workflows through the Ovid worker.
-func Register(cmd *cli.Command, w worker.Worker) {
-	a := API{thrippy: thrippy.NewLinkClient(cmd.String("thrippy-link-slack"), cmd)}
+// They send HTTP requests to the Slack API with the given client (or with
+// a default one, if it is nil).
+func Register(cmd *cli.Command, w worker.Worker, c *client.Client) {
+	a := newAPI(cmd, c)
+	a.limiter = newRateLimiter(cmd)
+	a.uploadDir = cmd.String("slack-upload-dir")
+	a.RegisterActivities(w)
+}
+
+// RegisterActivities exposes the receiver's methods as Temporal activities,
+// with the same names as in [Register]. This is useful in Temporal test
+// environments, with an [API] which is initialized by [NewStaticAPI].
+func (a *API) RegisterActivities(w worker.ActivityRegistry) {
+	registerActivity(w, a.AdminConversationsArchiveActivity, AdminConversationsArchiveName)
+	registerActivity(w, a.AdminConversationsCreateActivity, AdminConversationsCreateName)
+	registerActivity(w, a.AdminConversationsSearchActivity, AdminConversationsSearchName)
+	registerActivity(w, a.AdminConversationsSearchAllActivity, AdminConversationsSearchAllName)
+	registerActivity(w, a.AdminConversationsSetTeamsActivity, AdminConversationsSetTeamsName)
+	registerActivity(w, a.AdminTeamsListActivity, AdminTeamsListName)
+	registerActivity(w, a.AdminTeamsListAllActivity, AdminTeamsListAllName)
+	registerActivity(w, a.AdminUsergroupsAddChannelsActivity, AdminUsergroupsAddChannelsName)
+	registerActivity(w, a.AdminUsergroupsAddTeamsActivity, AdminUsergroupsAddTeamsName)
+	registerActivity(w, a.AdminUsergroupsListChannelsActivity, AdminUsergroupsListChannelsName)
+	registerActivity(w, a.AdminUsergroupsRemoveChannelsActivity, AdminUsergroupsRemoveChannelsName)
+	registerActivity(w, a.AdminUsersAssignActivity, AdminUsersAssignName)
+	registerActivity(w, a.AdminUsersInviteActivity, AdminUsersInviteName)
+	registerActivity(w, a.AdminUsersRemoveActivity, AdminUsersRemoveName)
+	registerActivity(w, a.AdminUsersSetAdminActivity, AdminUsersSetAdminName)
+
+	registerActivity(w, a.AuthTestActivity, AuthTestName)
+
+	registerActivity(w, a.BookmarksAddActivity, BookmarksAddName)
+	registerActivity(w, a.BookmarksEditActivity, BookmarksEditName)
+	registerActivity(w, a.BookmarksListActivity, BookmarksListName)
+	registerActivity(w, a.BookmarksRemoveActivity, BookmarksRemoveName)
+
+	registerActivity(w, a.BotsInfoActivity, BotsInfoName)
+
+	registerActivity(w, a.CanvasesAccessDeleteActivity, CanvasesAccessDeleteName)
+	registerActivity(w, a.CanvasesAccessSetActivity, CanvasesAccessSetName)
+	registerActivity(w, a.CanvasesCreateActivity, CanvasesCreateName)
+	registerActivity(w, a.CanvasesDeleteActivity, CanvasesDeleteName)
+	registerActivity(w, a.CanvasesEditActivity, CanvasesEditName)
+	registerActivity(w, a.CanvasesSectionsLookupActivity, CanvasesSectionsLookupName)
 
 	registerActivity(w, a.ChatDeleteActivity, ChatDeleteName)
+	registerActivity(w, a.ChatDeleteScheduledMessageActivity, ChatDeleteScheduledMessageName)
 	registerActivity(w, a.ChatGetPermalinkActivity, ChatGetPermalinkName)
+	registerActivity(w, a.ChatMeMessageActivity, ChatMeMessageName)
 	registerActivity(w, a.ChatPostEphemeralActivity, ChatPostEphemeralName)
 	registerActivity(w, a.ChatPostMessageActivity, ChatPostMessageName)
+	registerActivity(w, a.ChatScheduleMessageActivity, ChatScheduleMessageName)
+	registerActivity(w, a.ChatScheduledMessagesListActivity, ChatScheduledMessagesListName)
+	registerActivity(w, a.ChatScheduledMessagesListAllActivity, ChatScheduledMessagesListAllName)
+	registerActivity(w, a.ChatUnfurlActivity, ChatUnfurlName)
 	registerActivity(w, a.ChatUpdateActivity, ChatUpdateName)
 
 	registerActivity(w, a.ConversationsArchiveActivity, ConversationsArchiveName)
+	registerActivity(w, a.ConversationsCanvasesCreateActivity, ConversationsCanvasesCreateName)
 	registerActivity(w, a.ConversationsCloseActivity, ConversationsCloseName)
 	registerActivity(w, a.ConversationsCreateActivity, ConversationsCreateName)
 	registerActivity(w, a.ConversationsHistoryActivity, ConversationsHistoryName)
+	registerActivity(w, a.ConversationsHistoryAllActivity, ConversationsHistoryAllName)
 	registerActivity(w, a.ConversationsInfoActivity, ConversationsInfoName)
 	registerActivity(w, a.ConversationsInviteActivity, ConversationsInviteName)
 	registerActivity(w, a.ConversationsJoinActivity, ConversationsJoinName)
 	registerActivity(w, a.ConversationsKickActivity, ConversationsKickName)
 	registerActivity(w, a.ConversationsLeaveActivity, ConversationsLeaveName)
 	registerActivity(w, a.ConversationsListActivity, ConversationsListName)
+	registerActivity(w, a.ConversationsListAllActivity, ConversationsListAllName)
 	registerActivity(w, a.ConversationsMembersActivity, ConversationsMembersName)
+	registerActivity(w, a.ConversationsMembersAllActivity, ConversationsMembersAllName)
 	registerActivity(w, a.ConversationsOpenActivity, ConversationsOpenName)
 	registerActivity(w, a.ConversationsRenameActivity, ConversationsRenameName)
 	registerActivity(w, a.ConversationsRepliesActivity, ConversationsRepliesName)
+	registerActivity(w, a.ConversationsRepliesAllActivity, ConversationsRepliesAllName)
 	registerActivity(w, a.ConversationsSetPurposeActivity, ConversationsSetPurposeName)
 	registerActivity(w, a.ConversationsSetTopicActivity, ConversationsSetTopicName)
 	registerActivity(w, a.ConversationsUnarchiveActivity, ConversationsUnarchiveName)
 
+	registerActivity(w, a.DndEndDndActivity, DndEndDndName)
+	registerActivity(w, a.DndEndSnoozeActivity, DndEndSnoozeName)
+	registerActivity(w, a.DndInfoActivity, DndInfoName)
+	registerActivity(w, a.DndSetSnoozeActivity, DndSetSnoozeName)
+	registerActivity(w, a.DndTeamInfoActivity, DndTeamInfoName)
+
+	registerActivity(w, a.EmojiListActivity, EmojiListName)
+
+	registerActivity(w, a.FilesCompleteUploadExternalActivity, FilesCompleteUploadExternalName)
+	registerActivity(w, a.FilesDeleteActivity, FilesDeleteName)
+	registerActivity(w, a.FilesGetUploadURLExternalActivity, FilesGetUploadURLExternalName)
+	registerActivity(w, a.FilesInfoActivity, FilesInfoName)
+	registerActivity(w, a.FilesListActivity, FilesListName)
+	registerActivity(w, a.FilesSharedPublicURLActivity, FilesSharedPublicURLName)
+	registerActivity(w, a.FilesUploadActivity, FilesUploadName)
+	registerActivity(w, a.FilesUploadToURLActivity, FilesUploadToURLName)
+
+	registerActivity(w, a.PinsAddActivity, PinsAddName)
+	registerActivity(w, a.PinsListActivity, PinsListName)
+	registerActivity(w, a.PinsRemoveActivity, PinsRemoveName)
+
 	registerActivity(w, a.ReactionsAddActivity, ReactionsAddName)
 	registerActivity(w, a.ReactionsGetActivity, ReactionsGetName)
 	registerActivity(w, a.ReactionsListActivity, ReactionsListName)
+	registerActivity(w, a.ReactionsListAllActivity, ReactionsListAllName)
 	registerActivity(w, a.ReactionsRemoveActivity, ReactionsRemoveName)
 
+	registerActivity(w, a.RemindersAddActivity, RemindersAddName)
+	registerActivity(w, a.RemindersCompleteActivity, RemindersCompleteName)
+	registerActivity(w, a.RemindersDeleteActivity, RemindersDeleteName)
+	registerActivity(w, a.RemindersInfoActivity, RemindersInfoName)
+	registerActivity(w, a.RemindersListActivity, RemindersListName)
+
+	registerActivity(w, a.SearchAllActivity, SearchAllName)
+	registerActivity(w, a.SearchFilesActivity, SearchFilesName)
+	registerActivity(w, a.SearchFilesAllActivity, SearchFilesAllName)
+	registerActivity(w, a.SearchMessagesActivity, SearchMessagesName)
+	registerActivity(w, a.SearchMessagesAllActivity, SearchMessagesAllName)
+
+	registerActivity(w, a.TeamInfoActivity, TeamInfoName)
+	registerActivity(w, a.TeamProfileGetActivity, TeamProfileGetName)
+
+	registerActivity(w, a.UsergroupsCreateActivity, UsergroupsCreateName)
+	registerActivity(w, a.UsergroupsDisableActivity, UsergroupsDisableName)
+	registerActivity(w, a.UsergroupsEnableActivity, UsergroupsEnableName)
+	registerActivity(w, a.UsergroupsListActivity, UsergroupsListName)
+	registerActivity(w, a.UsergroupsUpdateActivity, UsergroupsUpdateName)
+	registerActivity(w, a.UsergroupsUsersListActivity, UsergroupsUsersListName)
+	registerActivity(w, a.UsergroupsUsersUpdateActivity, UsergroupsUsersUpdateName)
+
 	registerActivity(w, a.UsersConversationsActivity, UsersConversationsName)
+	registerActivity(w, a.UsersConversationsAllActivity, UsersConversationsAllName)
 	registerActivity(w, a.UsersGetPresenceActivity, UsersGetPresenceName)
 	registerActivity(w, a.UsersIdentityActivity, UsersIdentityName)
 	registerActivity(w, a.UsersInfoActivity, UsersInfoName)
 	registerActivity(w, a.UsersListActivity, UsersListName)
+	registerActivity(w, a.UsersListAllActivity, UsersListAllName)
 	registerActivity(w, a.UsersLookupByEmailActivity, UsersLookupByEmailName)
 	registerActivity(w, a.UsersProfileGetActivity, UsersProfileGetName)
+	registerActivity(w, a.UsersProfileSetActivity, UsersProfileSetName)
+	registerActivity(w, a.UsersSetPresenceActivity, UsersSetPresenceName)
+
+	registerActivity(w, a.ViewsOpenActivity, ViewsOpenName)
+	registerActivity(w, a.ViewsPublishActivity, ViewsPublishName)
+	registerActivity(w, a.ViewsPushActivity, ViewsPushName)
+	registerActivity(w, a.ViewsUpdateActivity, ViewsUpdateName)
+}
+
+// CheckLink validates the configured Thrippy links for Slack by calling the
+// "auth.test" Slack API method with each of them, so that the Ovid worker fails
+// fast if it's misconfigured. It does nothing if no Slack links are configured.
+func CheckLink(ctx context.Context, cmd *cli.Command, c *client.Client) error {
+	a := newAPI(cmd, c)
+	if a.thrippy.LinkID != "" {
+		if err := a.checkLink(ctx, ""); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(a.links)) {
+		if a.links[name].LinkID == a.thrippy.LinkID {
+			continue // Already checked.
+		}
+		if err := a.checkLink(ctx, name); err != nil {
+			return fmt.Errorf("Slack link %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// checkLink calls the "auth.test" Slack API method with a
+// specific named link, or the default link if the name is empty.
+func (a *API) checkLink(ctx context.Context, name string) error {
+	req := &AuthTestRequest{CallOptions: CallOptions{Link: name}}
+	resp, err := a.AuthTestActivity(withCallOptions(ctx, req), req)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("link_name", name).Str("link_id", a.link(withCallOptions(ctx, req)).LinkID).
+		Str("team", resp.Team).Str("user", resp.User).Str("url", resp.URL).Msg("validated Thrippy link for Slack")
+	return nil
 }
 
-func registerActivity(w worker.Worker, f any, name string) {
-	w.RegisterActivityWithOptions(f, activity.RegisterOptions{Name: name})
+// registerActivity registers an activity function under the given
+// name, and applies the [CallOptions] in its request, if it has any.
+func registerActivity[Req, Resp any](w worker.ActivityRegistry, f func(context.Context, Req) (Resp, error), name string) {
+	g := func(ctx context.Context, req Req) (Resp, error) {
+		return f(withCallOptions(ctx, req), req)
+	}
+	w.RegisterActivityWithOptions(g, activity.RegisterOptions{Name: name})
 }
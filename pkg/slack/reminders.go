@@ -0,0 +1,187 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+)
+
+const (
+	RemindersAddName      = "slack.reminders.add"
+	RemindersCompleteName = "slack.reminders.complete"
+	RemindersDeleteName   = "slack.reminders.delete"
+	RemindersInfoName     = "slack.reminders.info"
+	RemindersListName     = "slack.reminders.list"
+)
+
+// Reminder is a Slack reminder. Time is set only for one-time reminders,
+// and Recurrence only for recurring ones.
+//
+// https://docs.slack.dev/reference/methods/reminders.info
+type Reminder struct {
+	ID         string      `json:"id"`
+	Creator    string      `json:"creator,omitempty"`
+	User       string      `json:"user,omitempty"`
+	Text       string      `json:"text,omitempty"`
+	Recurring  bool        `json:"recurring,omitempty"`
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+	Time       int64       `json:"time,omitempty"`
+	CompleteTS int64       `json:"complete_ts,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/reminders.add
+type Recurrence struct {
+	Frequency string   `json:"frequency"` // "daily", "weekly", "monthly" or "yearly".
+	Weekdays  []string `json:"weekdays,omitempty"`
+}
+
+// RemindersAddRequest's Time may be a Unix timestamp (up to five
+// years from now), the number of seconds until the reminder (if
+// within 24 hours), or a natural language description ("in 15 minutes").
+//
+// https://docs.slack.dev/reference/methods/reminders.add
+type RemindersAddRequest struct {
+	CallOptions
+
+	Text string `json:"text"`
+	Time string `json:"time"`
+
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+	TeamID     string      `json:"team_id,omitempty"`
+	User       string      `json:"user,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/reminders.add
+type RemindersAddResponse struct {
+	slackResponse
+
+	Reminder *Reminder `json:"reminder,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/reminders.add
+func (a *API) RemindersAddActivity(ctx context.Context, req *RemindersAddRequest) (*RemindersAddResponse, error) {
+	resp := new(RemindersAddResponse)
+	if err := a.httpPost(ctx, RemindersAddName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/reminders.complete
+type RemindersCompleteRequest struct {
+	CallOptions
+
+	Reminder string `json:"reminder"`
+
+	TeamID string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/reminders.complete
+type RemindersCompleteResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/reminders.complete
+func (a *API) RemindersCompleteActivity(ctx context.Context, req *RemindersCompleteRequest) (*RemindersCompleteResponse, error) {
+	resp := new(RemindersCompleteResponse)
+	if err := a.httpPost(ctx, RemindersCompleteName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/reminders.delete
+type RemindersDeleteRequest struct {
+	CallOptions
+
+	Reminder string `json:"reminder"`
+
+	TeamID string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/reminders.delete
+type RemindersDeleteResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/reminders.delete
+func (a *API) RemindersDeleteActivity(ctx context.Context, req *RemindersDeleteRequest) (*RemindersDeleteResponse, error) {
+	resp := new(RemindersDeleteResponse)
+	if err := a.httpPost(ctx, RemindersDeleteName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/reminders.info
+type RemindersInfoRequest struct {
+	CallOptions
+
+	Reminder string `json:"reminder"`
+
+	TeamID string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/reminders.info
+type RemindersInfoResponse struct {
+	slackResponse
+
+	Reminder *Reminder `json:"reminder,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/reminders.info
+func (a *API) RemindersInfoActivity(ctx context.Context, req *RemindersInfoRequest) (*RemindersInfoResponse, error) {
+	query := url.Values{}
+	query.Set("reminder", req.Reminder)
+	if req.TeamID != "" {
+		query.Set("team_id", req.TeamID)
+	}
+
+	resp := new(RemindersInfoResponse)
+	if err := a.httpGet(ctx, RemindersInfoName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/reminders.list
+type RemindersListRequest struct {
+	CallOptions
+
+	TeamID string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/reminders.list
+type RemindersListResponse struct {
+	slackResponse
+
+	Reminders []Reminder `json:"reminders,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/reminders.list
+func (a *API) RemindersListActivity(ctx context.Context, req *RemindersListRequest) (*RemindersListResponse, error) {
+	query := url.Values{}
+	if req.TeamID != "" {
+		query.Set("team_id", req.TeamID)
+	}
+
+	resp := new(RemindersListResponse)
+	if err := a.httpGet(ctx, RemindersListName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
@@ -0,0 +1,324 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+const (
+	SearchAllName         = "slack.search.all"
+	SearchFilesName       = "slack.search.files"
+	SearchFilesAllName    = "slack.search.files.all"
+	SearchMessagesName    = "slack.search.messages"
+	SearchMessagesAllName = "slack.search.messages.all"
+)
+
+// SearchMessage is a Slack message in search results. Unlike [Message], its
+// channel is an object. Fields which aren't defined explicitly in this
+// struct are preserved in Extra.
+//
+// https://docs.slack.dev/reference/methods/search.messages
+type SearchMessage struct {
+	Type      string   `json:"type,omitempty"`
+	IID       string   `json:"iid,omitempty"`
+	TS        string   `json:"ts,omitempty"`
+	Channel   *Channel `json:"channel,omitempty"`
+	Team      string   `json:"team,omitempty"`
+	User      string   `json:"user,omitempty"`
+	Username  string   `json:"username,omitempty"`
+	Permalink string   `json:"permalink,omitempty"`
+
+	Text        string           `json:"text,omitempty"`
+	Blocks      []map[string]any `json:"blocks,omitempty"`
+	Attachments []map[string]any `json:"attachments,omitempty"`
+
+	Extra map[string]any `json:"-"`
+}
+
+func (m *SearchMessage) UnmarshalJSON(b []byte) error {
+	type searchMessage SearchMessage
+	extra, err := unmarshalWithExtra(b, (*searchMessage)(m))
+	m.Extra = extra
+	return err
+}
+
+func (m SearchMessage) MarshalJSON() ([]byte, error) {
+	type searchMessage SearchMessage
+	return marshalWithExtra(searchMessage(m), m.Extra)
+}
+
+// SearchMessages is the message matches of a Slack search.
+type SearchMessages struct {
+	Total   int             `json:"total"`
+	Matches []SearchMessage `json:"matches,omitempty"`
+	Paging  *SearchPaging   `json:"paging,omitempty"`
+}
+
+// SearchFiles is the file matches of a Slack search.
+type SearchFiles struct {
+	Total   int           `json:"total"`
+	Matches []File        `json:"matches,omitempty"`
+	Paging  *SearchPaging `json:"paging,omitempty"`
+}
+
+// SearchPaging describes a page of Slack search results. Page numbers start at 1.
+type SearchPaging struct {
+	Count int `json:"count"`
+	Total int `json:"total"`
+	Page  int `json:"page"`
+	Pages int `json:"pages"`
+}
+
+// nextPage returns the number of the page after this one,
+// or 0 if this is the last one (or there are no results).
+func (p *SearchPaging) nextPage() int {
+	if p == nil || p.Page >= p.Pages {
+		return 0
+	}
+	return p.Page + 1
+}
+
+// https://docs.slack.dev/reference/methods/search.all
+type SearchAllRequest struct {
+	CallOptions
+
+	Query string `json:"query"`
+
+	Count     int    `json:"count,omitempty"`
+	Highlight bool   `json:"highlight,omitempty"`
+	Page      int    `json:"page,omitempty"`
+	Sort      string `json:"sort,omitempty"`
+	SortDir   string `json:"sort_dir,omitempty"`
+	TeamID    string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/search.all
+type SearchAllResponse struct {
+	slackResponse
+
+	Query    string          `json:"query,omitempty"`
+	Messages *SearchMessages `json:"messages,omitempty"`
+	Files    *SearchFiles    `json:"files,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/search.all
+func (a *API) SearchAllActivity(ctx context.Context, req *SearchAllRequest) (*SearchAllResponse, error) {
+	query := searchQuery(req.Query, req.Count, req.Highlight, req.Page, req.Sort, req.SortDir, req.TeamID)
+
+	resp := new(SearchAllResponse)
+	if err := a.httpGet(ctx, SearchAllName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/search.files
+type SearchFilesRequest struct {
+	CallOptions
+
+	Query string `json:"query"`
+
+	Count     int    `json:"count,omitempty"`
+	Highlight bool   `json:"highlight,omitempty"`
+	Page      int    `json:"page,omitempty"`
+	Sort      string `json:"sort,omitempty"`
+	SortDir   string `json:"sort_dir,omitempty"`
+	TeamID    string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/search.files
+type SearchFilesResponse struct {
+	slackResponse
+
+	Query string       `json:"query,omitempty"`
+	Files *SearchFiles `json:"files,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/search.files
+func (a *API) SearchFilesActivity(ctx context.Context, req *SearchFilesRequest) (*SearchFilesResponse, error) {
+	query := searchQuery(req.Query, req.Count, req.Highlight, req.Page, req.Sort, req.SortDir, req.TeamID)
+
+	resp := new(SearchFilesResponse)
+	if err := a.httpGet(ctx, SearchFilesName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/search.files
+type SearchFilesAllRequest struct {
+	SearchFilesRequest
+
+	MaxItems int `json:"max_items,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/search.files
+type SearchFilesAllResponse struct {
+	Files    []File `json:"files,omitempty"`
+	NextPage int    `json:"next_page,omitempty"`
+}
+
+// SearchFilesAllActivity is page-based, unlike the other "all" activities:
+// it starts from the request's page, and if it stops because of the
+// request's max items, the response points to the next page.
+//
+// https://docs.slack.dev/reference/methods/search.files
+func (a *API) SearchFilesAllActivity(ctx context.Context, req *SearchFilesAllRequest) (*SearchFilesAllResponse, error) {
+	items, next, err := paginateSearch(ctx, req.Page, req.MaxItems, func(ctx context.Context, page int) ([]File, int, error) {
+		r := req.SearchFilesRequest
+		r.Page = page
+		resp, err := a.SearchFilesActivity(ctx, &r)
+		if err != nil || resp.Files == nil {
+			return nil, 0, err
+		}
+		return resp.Files.Matches, resp.Files.Paging.nextPage(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchFilesAllResponse{Files: items, NextPage: next}, nil
+}
+
+// https://docs.slack.dev/reference/methods/search.messages
+type SearchMessagesRequest struct {
+	CallOptions
+
+	Query string `json:"query"`
+
+	Count     int    `json:"count,omitempty"`
+	Highlight bool   `json:"highlight,omitempty"`
+	Page      int    `json:"page,omitempty"`
+	Sort      string `json:"sort,omitempty"`
+	SortDir   string `json:"sort_dir,omitempty"`
+	TeamID    string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/search.messages
+type SearchMessagesResponse struct {
+	slackResponse
+
+	Query    string          `json:"query,omitempty"`
+	Messages *SearchMessages `json:"messages,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/search.messages
+func (a *API) SearchMessagesActivity(ctx context.Context, req *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	query := searchQuery(req.Query, req.Count, req.Highlight, req.Page, req.Sort, req.SortDir, req.TeamID)
+
+	resp := new(SearchMessagesResponse)
+	if err := a.httpGet(ctx, SearchMessagesName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/search.messages
+type SearchMessagesAllRequest struct {
+	SearchMessagesRequest
+
+	MaxItems int `json:"max_items,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/search.messages
+type SearchMessagesAllResponse struct {
+	Messages []SearchMessage `json:"messages,omitempty"`
+	NextPage int             `json:"next_page,omitempty"`
+}
+
+// SearchMessagesAllActivity is page-based, unlike the other "all" activities:
+// it starts from the request's page, and if it stops because of the
+// request's max items, the response points to the next page.
+//
+// https://docs.slack.dev/reference/methods/search.messages
+func (a *API) SearchMessagesAllActivity(ctx context.Context, req *SearchMessagesAllRequest) (*SearchMessagesAllResponse, error) {
+	items, next, err := paginateSearch(ctx, req.Page, req.MaxItems, func(ctx context.Context, page int) ([]SearchMessage, int, error) {
+		r := req.SearchMessagesRequest
+		r.Page = page
+		resp, err := a.SearchMessagesActivity(ctx, &r)
+		if err != nil || resp.Messages == nil {
+			return nil, 0, err
+		}
+		return resp.Messages.Matches, resp.Messages.Paging.nextPage(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchMessagesAllResponse{Messages: items, NextPage: next}, nil
+}
+
+// searchQuery constructs the query parameters which are common to all the search methods.
+func searchQuery(q string, count int, highlight bool, page int, sort, sortDir, teamID string) url.Values {
+	query := url.Values{}
+	query.Set("query", q)
+	if count != 0 {
+		query.Set("count", strconv.Itoa(count))
+	}
+	if highlight {
+		query.Set("highlight", "true")
+	}
+	if page != 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if sort != "" {
+		query.Set("sort", sort)
+	}
+	if sortDir != "" {
+		query.Set("sort_dir", sortDir)
+	}
+	if teamID != "" {
+		query.Set("team_id", teamID)
+	}
+	return query
+}
+
+// paginateSearch adapts the page numbers of Slack search methods to [paginate],
+// by using them as cursors. Page numbers are 1-based, so 0 means the first page
+// in the input, and no more pages in the output. An empty cursor (e.g. when
+// resuming from heartbeat details after the last page) means there are no
+// more pages.
+func paginateSearch[T any](ctx context.Context, page, maxItems int, f func(context.Context, int) ([]T, int, error)) ([]T, int, error) {
+	items, next, err := paginate(ctx, strconv.Itoa(max(page, 1)), maxItems, func(ctx context.Context, cursor string) ([]T, string, error) {
+		if cursor == "" {
+			return nil, "", nil
+		}
+		n, err := searchPage(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		items, next, err := f(ctx, n)
+		if err != nil || next == 0 {
+			return items, "", err
+		}
+		return items, strconv.Itoa(next), nil
+	})
+	if err != nil || next == "" {
+		return items, 0, err
+	}
+
+	n, err := searchPage(next)
+	return items, n, err
+}
+
+// searchPage converts a [paginate] cursor back into a Slack search page number.
+func searchPage(cursor string) (int, error) {
+	n, err := strconv.Atoi(cursor)
+	if err != nil || n < 1 {
+		msg := "invalid Slack search pagination cursor: " + cursor
+		return 0, temporal.NewNonRetryableApplicationError(msg, "error", err, cursor)
+	}
+	return n, nil
+}
@@ -0,0 +1,289 @@
+package slacktest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tzrikka/ovid/pkg/slack"
+)
+
+// https://docs.slack.dev/reference/methods/chat.delete
+func chatDelete(s *Server, caller string, p params) (response, string) {
+	c, m, code := s.findMessage(p.str("channel"), p.str("ts"))
+	if code != "" {
+		return nil, code
+	}
+	if m.User != caller {
+		return nil, "cant_delete_message"
+	}
+
+	c.messages = slices.DeleteFunc(c.messages, func(x *slack.Message) bool { return x == m })
+	if m.ThreadTS != "" && m.ThreadTS != m.TS {
+		if parent := c.message(m.ThreadTS); parent != nil {
+			parent.ReplyCount--
+		}
+	}
+
+	return response{"channel": c.info.ID, "ts": m.TS}, ""
+}
+
+// https://docs.slack.dev/reference/methods/chat.deleteScheduledMessage
+func chatDeleteScheduledMessage(s *Server, _ string, p params) (response, string) {
+	id, channelID := p.str("scheduled_message_id"), p.str("channel")
+	if s.channel(channelID) == nil {
+		return nil, "channel_not_found"
+	}
+
+	i := slices.IndexFunc(s.scheduled, func(m slack.ScheduledMessage) bool {
+		return m.ID == id && m.ChannelID == channelID
+	})
+	if i < 0 {
+		return nil, "invalid_scheduled_message_id"
+	}
+
+	s.scheduled = slices.Delete(s.scheduled, i, i+1)
+	return nil, ""
+}
+
+// https://docs.slack.dev/reference/methods/chat.getPermalink
+func chatGetPermalink(s *Server, _ string, p params) (response, string) {
+	c, m, code := s.findMessage(p.str("channel"), p.str("message_ts"))
+	if code != "" {
+		return nil, code
+	}
+
+	return response{"channel": c.info.ID, "permalink": permalink(c.info.ID, m.TS)}, ""
+}
+
+// https://docs.slack.dev/reference/methods/chat.meMessage
+func chatMeMessage(s *Server, caller string, p params) (response, string) {
+	c, code := s.postableChannel(caller, p.str("channel"))
+	if code != "" {
+		return nil, code
+	}
+	if p.str("text") == "" {
+		return nil, "no_text"
+	}
+
+	m := &slack.Message{
+		Type:    "message",
+		Subtype: "me_message",
+		TS:      s.nextTS(),
+		Team:    TeamID,
+		User:    caller,
+		Text:    p.str("text"),
+	}
+	c.addMessage(m)
+
+	return response{"channel": c.info.ID, "ts": m.TS}, ""
+}
+
+// https://docs.slack.dev/reference/methods/chat.postEphemeral
+func chatPostEphemeral(s *Server, caller string, p params) (response, string) {
+	c, code := s.postableChannel(caller, p.str("channel"))
+	if code != "" {
+		return nil, code
+	}
+	if s.user(p.str("user")) == nil {
+		return nil, "user_not_found"
+	}
+	if !c.isMember(p.str("user")) {
+		return nil, "user_not_in_channel"
+	}
+	if !hasContent(p) {
+		return nil, "no_text"
+	}
+
+	// Ephemeral messages are visible only to their recipient, and aren't stored.
+	return response{"message_ts": s.nextTS()}, ""
+}
+
+// https://docs.slack.dev/reference/methods/chat.postMessage
+func chatPostMessage(s *Server, caller string, p params) (response, string) {
+	c, code := s.postableChannel(caller, p.str("channel"))
+	if code != "" {
+		return nil, code
+	}
+	if !hasContent(p) {
+		return nil, "no_text"
+	}
+
+	m := &slack.Message{
+		Type:     "message",
+		TS:       s.nextTS(),
+		Team:     TeamID,
+		User:     caller,
+		Username: p.str("username"),
+		Text:     p.str("text"),
+	}
+	if m.Text == "" {
+		m.Text = p.str("markdown_text")
+	}
+	p.decode("blocks", &m.Blocks)
+	p.decode("attachments", &m.Attachments)
+	p.decode("metadata", &m.Metadata)
+
+	if ts := p.str("thread_ts"); ts != "" {
+		parent := c.message(ts)
+		if parent == nil {
+			return nil, "thread_not_found"
+		}
+		addReply(parent, m)
+	}
+
+	c.addMessage(m)
+	return response{"channel": c.info.ID, "ts": m.TS, "message": copyMessage(m)}, ""
+}
+
+// https://docs.slack.dev/reference/methods/chat.scheduleMessage
+func chatScheduleMessage(s *Server, caller string, p params) (response, string) {
+	c, code := s.postableChannel(caller, p.str("channel"))
+	if code != "" {
+		return nil, code
+	}
+	if !hasContent(p) {
+		return nil, "no_text"
+	}
+
+	now := time.Now().Unix()
+	postAt := p.int("post_at")
+	if postAt <= now {
+		return nil, "time_in_past"
+	}
+
+	m := slack.ScheduledMessage{
+		ID:          s.nextID("Q"),
+		ChannelID:   c.info.ID,
+		PostAt:      postAt,
+		DateCreated: now,
+		Text:        p.str("text"),
+	}
+	s.scheduled = append(s.scheduled, m)
+
+	msg := slack.Message{Type: "message", Team: TeamID, User: caller, Text: m.Text}
+	return response{"channel": c.info.ID, "scheduled_message_id": m.ID, "post_at": postAt, "message": msg}, ""
+}
+
+// https://docs.slack.dev/reference/methods/chat.scheduledMessages.list
+func chatScheduledMessagesList(s *Server, _ string, p params) (response, string) {
+	channelID := p.str("channel")
+	if channelID != "" && s.channel(channelID) == nil {
+		return nil, "channel_not_found"
+	}
+
+	var msgs []slack.ScheduledMessage
+	for _, m := range s.scheduled {
+		if channelID == "" || m.ChannelID == channelID {
+			msgs = append(msgs, m)
+		}
+	}
+
+	page, next, code := paginate(msgs, p)
+	if code != "" {
+		return nil, code
+	}
+	return withCursor(response{"scheduled_messages": page}, next), ""
+}
+
+// https://docs.slack.dev/reference/methods/chat.unfurl
+func chatUnfurl(s *Server, _ string, p params) (response, string) {
+	if p.str("channel") != "" {
+		if _, _, code := s.findMessage(p.str("channel"), p.str("ts")); code != "" {
+			return nil, code
+		}
+	}
+
+	var unfurls map[string]any
+	if !p.decode("unfurls", &unfurls) && !p.bool("user_auth_required") {
+		return nil, "invalid_unfurls_format"
+	}
+
+	return nil, ""
+}
+
+// https://docs.slack.dev/reference/methods/chat.update
+func chatUpdate(s *Server, caller string, p params) (response, string) {
+	c, m, code := s.findMessage(p.str("channel"), p.str("ts"))
+	if code != "" {
+		return nil, code
+	}
+	if m.User != caller {
+		return nil, "cant_update_message"
+	}
+
+	if text := p.str("text"); text != "" {
+		m.Text = text
+	} else if text := p.str("markdown_text"); text != "" {
+		m.Text = text
+	}
+	p.decode("blocks", &m.Blocks)
+	p.decode("attachments", &m.Attachments)
+	p.decode("metadata", &m.Metadata)
+	m.Edited = &slack.Edited{User: caller, TS: s.nextTS()}
+
+	return response{"channel": c.info.ID, "ts": m.TS, "text": m.Text, "message": copyMessage(m)}, ""
+}
+
+// postableChannel returns a channel which the caller may post messages in.
+func (s *Server) postableChannel(caller, id string) (*channel, string) {
+	c := s.channel(id)
+	switch {
+	case c == nil:
+		return nil, "channel_not_found"
+	case (c.info.IsPrivate || c.info.IsIM || c.info.IsMPIM) && !c.isMember(caller):
+		return nil, "channel_not_found" // Private conversations are hidden from non-members.
+	case c.info.IsArchived:
+		return nil, "is_archived"
+	default:
+		return c, ""
+	}
+}
+
+// findMessage returns a message by its channel ID and timestamp.
+func (s *Server) findMessage(channelID, ts string) (*channel, *slack.Message, string) {
+	c := s.channel(channelID)
+	if c == nil {
+		return nil, nil, "channel_not_found"
+	}
+
+	m := c.message(ts)
+	if m == nil {
+		return nil, nil, "message_not_found"
+	}
+
+	return c, m, ""
+}
+
+// hasContent checks whether a new message has
+// any content: text, blocks, or attachments.
+func hasContent(p params) bool {
+	for _, k := range []string{"text", "markdown_text", "blocks", "attachments"} {
+		if v, ok := p[k]; ok && v != nil && v != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// addReply updates the thread-related fields of a parent message
+// when a new reply is added to it, and of the reply itself.
+func addReply(parent, reply *slack.Message) {
+	if parent.ThreadTS == "" {
+		parent.ThreadTS = parent.TS
+	}
+	reply.ThreadTS = parent.TS
+	reply.ParentUserID = parent.User
+
+	parent.ReplyCount++
+	parent.LatestReply = reply.TS
+	if !slices.Contains(parent.ReplyUsers, reply.User) {
+		parent.ReplyUsers = append(parent.ReplyUsers, reply.User)
+		parent.ReplyUsersCount = len(parent.ReplyUsers)
+	}
+}
+
+func permalink(channelID, ts string) string {
+	return fmt.Sprintf("https://slacktest.slack.com/archives/%s/p%s", channelID, strings.ReplaceAll(ts, ".", ""))
+}
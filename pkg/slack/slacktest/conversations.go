@@ -0,0 +1,450 @@
+package slacktest
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tzrikka/ovid/pkg/slack"
+)
+
+// https://docs.slack.dev/reference/methods/conversations.archive
+func conversationsArchive(s *Server, _ string, p params) (response, string) {
+	c := s.channel(p.str("channel"))
+	switch {
+	case c == nil:
+		return nil, "channel_not_found"
+	case c.info.IsGeneral:
+		return nil, "cant_archive_general"
+	case c.info.IsIM || c.info.IsMPIM:
+		return nil, "method_not_supported_for_channel_type"
+	case c.info.IsArchived:
+		return nil, "already_archived"
+	}
+
+	c.info.IsArchived = true
+	return nil, ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.canvases.create
+func conversationsCanvasesCreate(s *Server, _ string, p params) (response, string) {
+	c := s.channel(p.str("channel_id"))
+	switch {
+	case c == nil:
+		return nil, "channel_not_found"
+	case c.canvas != "":
+		return nil, "channel_canvas_already_exists"
+	}
+
+	c.canvas = s.nextID("F")
+	return response{"canvas_id": c.canvas}, ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.close
+func conversationsClose(s *Server, _ string, p params) (response, string) {
+	c := s.channel(p.str("channel"))
+	switch {
+	case c == nil:
+		return nil, "channel_not_found"
+	case !c.info.IsIM && !c.info.IsMPIM:
+		return nil, "method_not_supported_for_channel_type"
+	}
+
+	return nil, ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.create
+func conversationsCreate(s *Server, caller string, p params) (response, string) {
+	name := p.str("name")
+	if name == "" {
+		return nil, "invalid_name_required"
+	}
+	if s.channelByName(name) != nil {
+		return nil, "name_taken"
+	}
+
+	c := s.addChannel(slack.Channel{
+		Name:      name,
+		IsPrivate: p.bool("is_private"),
+		IsGroup:   p.bool("is_private"),
+		IsChannel: !p.bool("is_private"),
+		Created:   time.Now().Unix(),
+		Creator:   caller,
+	}, []string{caller})
+
+	return response{"channel": c.view(caller)}, ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.history
+func conversationsHistory(s *Server, caller string, p params) (response, string) {
+	c, code := s.readableChannel(caller, p.str("channel"))
+	if code != "" {
+		return nil, code
+	}
+
+	// Newest first, without thread replies.
+	var msgs []slack.Message
+	for _, m := range slices.Backward(c.messages) {
+		if m.ThreadTS != "" && m.ThreadTS != m.TS {
+			continue
+		}
+		if inRange(m.TS, p) {
+			msgs = append(msgs, copyMessage(m))
+		}
+	}
+
+	page, next, code := paginate(msgs, p)
+	if code != "" {
+		return nil, code
+	}
+	return withCursor(response{"messages": page, "has_more": next != ""}, next), ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.info
+func conversationsInfo(s *Server, caller string, p params) (response, string) {
+	c, code := s.readableChannel(caller, p.str("channel"))
+	if code != "" {
+		return nil, code
+	}
+
+	return response{"channel": c.view(caller)}, ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.invite
+func conversationsInvite(s *Server, caller string, p params) (response, string) {
+	c, code := s.readableChannel(caller, p.str("channel"))
+	switch {
+	case code != "":
+		return nil, code
+	case c.info.IsIM:
+		return nil, "method_not_supported_for_channel_type"
+	case c.info.IsArchived:
+		return nil, "is_archived"
+	case !c.isMember(caller):
+		return nil, "not_in_channel"
+	}
+
+	users := p.list("users")
+	if len(users) == 0 {
+		return nil, "no_user"
+	}
+	for _, u := range users {
+		switch {
+		case u == caller:
+			return nil, "cant_invite_self"
+		case s.user(u) == nil:
+			return nil, "user_not_found"
+		case c.isMember(u):
+			return nil, "already_in_channel"
+		}
+	}
+
+	c.members = append(c.members, users...)
+	return response{"channel": c.view(caller)}, ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.join
+func conversationsJoin(s *Server, caller string, p params) (response, string) {
+	c := s.channel(p.str("channel"))
+	switch {
+	case c == nil:
+		return nil, "channel_not_found"
+	case c.info.IsPrivate || c.info.IsIM || c.info.IsMPIM:
+		return nil, "method_not_supported_for_channel_type"
+	case c.info.IsArchived:
+		return nil, "is_archived"
+	}
+
+	if !c.isMember(caller) {
+		c.members = append(c.members, caller)
+	}
+	return response{"channel": c.view(caller)}, ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.kick
+func conversationsKick(s *Server, caller string, p params) (response, string) {
+	c, code := s.readableChannel(caller, p.str("channel"))
+	if code != "" {
+		return nil, code
+	}
+
+	u := p.str("user")
+	switch {
+	case c.info.IsIM || c.info.IsMPIM:
+		return nil, "method_not_supported_for_channel_type"
+	case u == caller:
+		return nil, "cant_kick_self"
+	case s.user(u) == nil:
+		return nil, "user_not_found"
+	case !c.isMember(u):
+		return nil, "not_in_channel"
+	}
+
+	c.members = slices.DeleteFunc(c.members, func(m string) bool { return m == u })
+	return nil, ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.leave
+func conversationsLeave(s *Server, caller string, p params) (response, string) {
+	c, code := s.readableChannel(caller, p.str("channel"))
+	switch {
+	case code != "":
+		return nil, code
+	case c.info.IsIM:
+		return nil, "method_not_supported_for_channel_type"
+	case c.info.IsGeneral:
+		return nil, "cant_leave_general"
+	case !c.isMember(caller):
+		return response{"not_in_channel": true}, ""
+	}
+
+	c.members = slices.DeleteFunc(c.members, func(m string) bool { return m == caller })
+	return nil, ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.list
+func conversationsList(s *Server, caller string, p params) (response, string) {
+	return s.listChannels(caller, p, func(*channel) bool { return true })
+}
+
+// https://docs.slack.dev/reference/methods/conversations.members
+func conversationsMembers(s *Server, caller string, p params) (response, string) {
+	c, code := s.readableChannel(caller, p.str("channel"))
+	if code != "" {
+		return nil, code
+	}
+
+	page, next, code := paginate(c.members, p)
+	if code != "" {
+		return nil, code
+	}
+	return withCursor(response{"members": slices.Clone(page)}, next), ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.open
+func conversationsOpen(s *Server, caller string, p params) (response, string) {
+	if id := p.str("channel"); id != "" {
+		c, code := s.readableChannel(caller, id)
+		if code != "" {
+			return nil, code
+		}
+		return response{"already_open": true, "channel": c.view(caller)}, ""
+	}
+
+	users := p.list("users")
+	if len(users) == 0 {
+		return nil, "users_list_not_supplied"
+	}
+	for _, u := range users {
+		if s.user(u) == nil {
+			return nil, "user_not_found"
+		}
+	}
+
+	members := append([]string{caller}, users...)
+	slices.Sort(members)
+	members = slices.Compact(members)
+
+	i := slices.IndexFunc(s.channels, func(c *channel) bool {
+		if !c.info.IsIM && !c.info.IsMPIM {
+			return false
+		}
+		m := slices.Clone(c.members)
+		slices.Sort(m)
+		return slices.Equal(m, members)
+	})
+	if i >= 0 {
+		return response{"already_open": true, "channel": s.channels[i].view(caller)}, ""
+	}
+
+	if p.bool("prevent_creation") {
+		return response{"no_op": true}, ""
+	}
+
+	ch := slack.Channel{IsIM: len(users) == 1, IsMPIM: len(users) > 1, IsPrivate: len(users) > 1, Created: time.Now().Unix()}
+	if ch.IsIM {
+		ch.User = users[0]
+	} else {
+		ch.Name = "mpdm-" + strings.Join(members, "--")
+	}
+
+	return response{"channel": s.addChannel(ch, members).view(caller)}, ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.rename
+func conversationsRename(s *Server, caller string, p params) (response, string) {
+	c, code := s.readableChannel(caller, p.str("channel"))
+	if code != "" {
+		return nil, code
+	}
+
+	name := p.str("name")
+	switch {
+	case c.info.IsIM || c.info.IsMPIM:
+		return nil, "method_not_supported_for_channel_type"
+	case !c.isMember(caller):
+		return nil, "not_in_channel"
+	case name == "":
+		return nil, "invalid_name_required"
+	case name != c.info.Name && s.channelByName(name) != nil:
+		return nil, "name_taken"
+	}
+
+	if name != c.info.Name {
+		c.info.PreviousNames = append(c.info.PreviousNames, c.info.Name)
+		c.info.Name, c.info.NameNormalized = name, name
+		c.info.Updated = time.Now().UnixMilli()
+	}
+	return response{"channel": c.view(caller)}, ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.replies
+func conversationsReplies(s *Server, caller string, p params) (response, string) {
+	c, code := s.readableChannel(caller, p.str("channel"))
+	if code != "" {
+		return nil, code
+	}
+
+	ts := p.str("ts")
+	parent := c.message(ts)
+	if parent == nil {
+		return nil, "thread_not_found"
+	}
+
+	// Oldest first, starting with the parent message.
+	msgs := []slack.Message{copyMessage(parent)}
+	for _, m := range c.messages {
+		if m.ThreadTS == ts && m.TS != ts && inRange(m.TS, p) {
+			msgs = append(msgs, copyMessage(m))
+		}
+	}
+
+	page, next, code := paginate(msgs, p)
+	if code != "" {
+		return nil, code
+	}
+	return withCursor(response{"messages": page, "has_more": next != ""}, next), ""
+}
+
+// https://docs.slack.dev/reference/methods/conversations.setPurpose
+func conversationsSetPurpose(s *Server, caller string, p params) (response, string) {
+	return s.setTopic(caller, p, "purpose")
+}
+
+// https://docs.slack.dev/reference/methods/conversations.setTopic
+func conversationsSetTopic(s *Server, caller string, p params) (response, string) {
+	return s.setTopic(caller, p, "topic")
+}
+
+// https://docs.slack.dev/reference/methods/conversations.unarchive
+func conversationsUnarchive(s *Server, _ string, p params) (response, string) {
+	c := s.channel(p.str("channel"))
+	switch {
+	case c == nil:
+		return nil, "channel_not_found"
+	case !c.info.IsArchived:
+		return nil, "not_archived"
+	}
+
+	c.info.IsArchived = false
+	return nil, ""
+}
+
+// listChannels returns a paginated list of channels which match the
+// "types" and "exclude_archived" parameters, and the given filter.
+func (s *Server) listChannels(caller string, p params, filter func(*channel) bool) (response, string) {
+	types := p.list("types")
+	if len(types) == 0 {
+		types = []string{"public_channel"}
+	}
+
+	var chs []slack.Channel
+	for _, c := range s.channels {
+		if !slices.Contains(types, channelType(c.info)) || !filter(c) {
+			continue
+		}
+		if p.bool("exclude_archived") && c.info.IsArchived {
+			continue
+		}
+		if channelType(c.info) != "public_channel" && !c.isMember(caller) {
+			continue // Private conversations are hidden from non-members.
+		}
+		chs = append(chs, c.view(caller))
+	}
+
+	page, next, code := paginate(chs, p)
+	if code != "" {
+		return nil, code
+	}
+	return withCursor(response{"channels": page}, next), ""
+}
+
+// readableChannel returns a channel which the caller may read: any
+// public channel, or a private conversation which the caller is a member of.
+func (s *Server) readableChannel(caller, id string) (*channel, string) {
+	c := s.channel(id)
+	if c == nil || (channelType(c.info) != "public_channel" && !c.isMember(caller)) {
+		return nil, "channel_not_found"
+	}
+	return c, ""
+}
+
+func (s *Server) channelByName(name string) *channel {
+	i := slices.IndexFunc(s.channels, func(c *channel) bool { return c.info.Name == name })
+	if i < 0 {
+		return nil
+	}
+	return s.channels[i]
+}
+
+func (s *Server) setTopic(caller string, p params, field string) (response, string) {
+	c, code := s.readableChannel(caller, p.str("channel"))
+	switch {
+	case code != "":
+		return nil, code
+	case c.info.IsArchived:
+		return nil, "is_archived"
+	case !c.isMember(caller):
+		return nil, "not_in_channel"
+	}
+
+	t := &slack.Topic{Value: p.str(field), Creator: caller, LastSet: time.Now().Unix()}
+	if field == "topic" {
+		c.info.Topic = t
+	} else {
+		c.info.Purpose = t
+	}
+	return response{"channel": c.view(caller)}, ""
+}
+
+// channelType returns the type of a channel, as in the "types" parameter of
+// the "conversations.list" and "users.conversations" API methods.
+func channelType(c slack.Channel) string {
+	switch {
+	case c.IsIM:
+		return "im"
+	case c.IsMPIM:
+		return "mpim"
+	case c.IsPrivate:
+		return "private_channel"
+	default:
+		return "public_channel"
+	}
+}
+
+// inRange checks whether a message timestamp is within the
+// range of the "oldest", "latest" and "inclusive" parameters.
+func inRange(ts string, p params) bool {
+	inclusive := p.bool("inclusive")
+	if oldest := p.str("oldest"); oldest != "" {
+		if cmp := compareTS(ts, oldest); cmp < 0 || (cmp == 0 && !inclusive) {
+			return false
+		}
+	}
+	if latest := p.str("latest"); latest != "" {
+		if cmp := compareTS(ts, latest); cmp > 0 || (cmp == 0 && !inclusive) {
+			return false
+		}
+	}
+	return true
+}
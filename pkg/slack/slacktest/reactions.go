@@ -0,0 +1,111 @@
+package slacktest
+
+import (
+	"slices"
+
+	"github.com/tzrikka/ovid/pkg/slack"
+)
+
+// https://docs.slack.dev/reference/methods/reactions.add
+func reactionsAdd(s *Server, caller string, p params) (response, string) {
+	_, m, code := s.findMessage(p.str("channel"), p.str("timestamp"))
+	if code != "" {
+		return nil, code
+	}
+
+	name := p.str("name")
+	if name == "" {
+		return nil, "invalid_name"
+	}
+
+	i := slices.IndexFunc(m.Reactions, func(r slack.Reaction) bool { return r.Name == name })
+	if i < 0 {
+		m.Reactions = append(m.Reactions, slack.Reaction{Name: name})
+		i = len(m.Reactions) - 1
+	}
+
+	r := &m.Reactions[i]
+	if slices.Contains(r.Users, caller) {
+		return nil, "already_reacted"
+	}
+	r.Users = append(r.Users, caller)
+	r.Count = len(r.Users)
+
+	return nil, ""
+}
+
+// https://docs.slack.dev/reference/methods/reactions.get
+func reactionsGet(s *Server, _ string, p params) (response, string) {
+	if p.str("file") != "" || p.str("file_comment") != "" {
+		return nil, "file_not_found" // Files aren't supported by this fake.
+	}
+
+	c, m, code := s.findMessage(p.str("channel"), p.str("timestamp"))
+	if code != "" {
+		return nil, code
+	}
+
+	msg := copyMessage(m)
+	return response{"type": "message", "channel": c.info.ID, "message": msg}, ""
+}
+
+// https://docs.slack.dev/reference/methods/reactions.list
+func reactionsList(s *Server, caller string, p params) (response, string) {
+	user := p.str("user")
+	if user == "" {
+		user = caller
+	}
+	if s.user(user) == nil {
+		return nil, "user_not_found"
+	}
+
+	var items []slack.ReactionItem
+	for _, c := range s.channels {
+		for _, m := range slices.Backward(c.messages) {
+			if !reactedBy(m, user) {
+				continue
+			}
+			msg := copyMessage(m)
+			items = append(items, slack.ReactionItem{Type: "message", Channel: c.info.ID, Message: &msg})
+		}
+	}
+
+	page, next, code := paginate(items, p)
+	if code != "" {
+		return nil, code
+	}
+	return withCursor(response{"items": page}, next), ""
+}
+
+// https://docs.slack.dev/reference/methods/reactions.remove
+func reactionsRemove(s *Server, caller string, p params) (response, string) {
+	if p.str("file") != "" || p.str("file_comment") != "" {
+		return nil, "file_not_found" // Files aren't supported by this fake.
+	}
+
+	_, m, code := s.findMessage(p.str("channel"), p.str("timestamp"))
+	if code != "" {
+		return nil, code
+	}
+
+	name := p.str("name")
+	i := slices.IndexFunc(m.Reactions, func(r slack.Reaction) bool { return r.Name == name })
+	if i < 0 || !slices.Contains(m.Reactions[i].Users, caller) {
+		return nil, "no_reaction"
+	}
+
+	r := &m.Reactions[i]
+	r.Users = slices.DeleteFunc(r.Users, func(u string) bool { return u == caller })
+	r.Count = len(r.Users)
+	if r.Count == 0 {
+		m.Reactions = slices.Delete(m.Reactions, i, i+1)
+	}
+
+	return nil, ""
+}
+
+func reactedBy(m *slack.Message, user string) bool {
+	return slices.ContainsFunc(m.Reactions, func(r slack.Reaction) bool {
+		return slices.Contains(r.Users, user)
+	})
+}
@@ -0,0 +1,603 @@
+// Package slacktest provides an in-process fake of the [Slack Web API], for
+// tests of Ovid's Slack activities and of Temporal workflows which call them.
+//
+// The fake [Server] implements the chat, conversations, reactions and users
+// methods which Ovid supports, with an in-memory workspace state (channels,
+// members, messages, reactions and channel canvases). It can also inject
+// errors, such as "ratelimited" or "channel_not_found", into specific API
+// methods.
+//
+// [Slack Web API]: https://docs.slack.dev/apis/web-api
+package slacktest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/tzrikka/ovid/pkg/client"
+	"github.com/tzrikka/ovid/pkg/slack"
+)
+
+// Static credentials and IDs of the fake Slack workspace.
+const (
+	BotToken  = "xoxb-slacktest"
+	UserToken = "xoxp-slacktest"
+
+	TeamID    = "T0SLACKTEST"
+	BotUserID = "U0SLACKBOT"  // The user of [BotToken].
+	UserID    = "U0SLACKUSER" // The user of [UserToken].
+)
+
+// RetryAfter is the value of the "Retry-After" header in injected "ratelimited" errors.
+const RetryAfter = 1 // Seconds.
+
+const (
+	defaultLimit = 100
+	cursorPrefix = "offset:"
+	firstTS      = 1700000000 // Seconds, before the first generated message timestamp.
+)
+
+// Server is a fake Slack Web API server. It's safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, for [slack.NewStaticAPI]. API methods
+	// are served under the "/api/" path, like in "https://slack.com".
+	URL string
+
+	srv *httptest.Server
+
+	mu        sync.Mutex
+	channels  []*channel // In creation order.
+	users     []*slack.User
+	presence  map[string]string
+	scheduled []slack.ScheduledMessage
+	errors    map[string][]string
+	calls     map[string]int
+	lastID    int
+	lastTS    int64 // Seconds of the latest message timestamp.
+}
+
+// handlers implement the Slack API methods, by their names.
+var handlers = map[string]handler{
+	"chat.delete":                 chatDelete,
+	"chat.deleteScheduledMessage": chatDeleteScheduledMessage,
+	"chat.getPermalink":           chatGetPermalink,
+	"chat.meMessage":              chatMeMessage,
+	"chat.postEphemeral":          chatPostEphemeral,
+	"chat.postMessage":            chatPostMessage,
+	"chat.scheduleMessage":        chatScheduleMessage,
+	"chat.scheduledMessages.list": chatScheduledMessagesList,
+	"chat.unfurl":                 chatUnfurl,
+	"chat.update":                 chatUpdate,
+
+	"conversations.archive":         conversationsArchive,
+	"conversations.canvases.create": conversationsCanvasesCreate,
+	"conversations.close":           conversationsClose,
+	"conversations.create":          conversationsCreate,
+	"conversations.history":         conversationsHistory,
+	"conversations.info":            conversationsInfo,
+	"conversations.invite":          conversationsInvite,
+	"conversations.join":            conversationsJoin,
+	"conversations.kick":            conversationsKick,
+	"conversations.leave":           conversationsLeave,
+	"conversations.list":            conversationsList,
+	"conversations.members":         conversationsMembers,
+	"conversations.open":            conversationsOpen,
+	"conversations.rename":          conversationsRename,
+	"conversations.replies":         conversationsReplies,
+	"conversations.setPurpose":      conversationsSetPurpose,
+	"conversations.setTopic":        conversationsSetTopic,
+	"conversations.unarchive":       conversationsUnarchive,
+
+	"reactions.add":    reactionsAdd,
+	"reactions.get":    reactionsGet,
+	"reactions.list":   reactionsList,
+	"reactions.remove": reactionsRemove,
+
+	"users.conversations": usersConversations,
+	"users.getPresence":   usersGetPresence,
+	"users.identity":      usersIdentity,
+	"users.info":          usersInfo,
+	"users.list":          usersList,
+	"users.lookupByEmail": usersLookupByEmail,
+	"users.profile.get":   usersProfileGet,
+	"users.profile.set":   usersProfileSet,
+	"users.setPresence":   usersSetPresence,
+}
+
+type channel struct {
+	info     slack.Channel
+	members  []string
+	messages []*slack.Message // Sorted by timestamp, including thread replies.
+	canvas   string           // File ID of the channel canvas, if there is one.
+}
+
+type (
+	params   map[string]any
+	response map[string]any
+	handler  func(s *Server, caller string, p params) (response, string)
+)
+
+// NewServer starts a fake Slack Web API server, with a workspace
+// which contains two users: a bot ([BotUserID]) and a human ([UserID]).
+// The caller should call [Server.Close] when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		presence: map[string]string{},
+		errors:   map[string][]string{},
+		calls:    map[string]int{},
+	}
+
+	s.AddUser(slack.User{ID: BotUserID, Name: "slacktest-bot", IsBot: true})
+	s.AddUser(slack.User{ID: UserID, Name: "slacktest-user", Profile: &slack.Profile{
+		RealName: "Slack Test",
+		Email:    "slacktest@example.com",
+	}})
+
+	s.srv = httptest.NewServer(http.HandlerFunc(s.handle))
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server, and blocks until all outstanding requests have completed.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// API returns a [slack.API] which sends requests to the receiver with the
+// static tokens [BotToken] and [UserToken], and the given HTTP client (or
+// one with default options, if it's nil). Use [slack.API.RegisterActivities]
+// to register it in a Temporal test environment.
+func (s *Server) API(c *client.Client) *slack.API {
+	creds := map[string]string{"bot_token": BotToken, "user_token": UserToken}
+	return slack.NewStaticAPI(s.URL, creds, c)
+}
+
+// AddChannel adds a channel to the workspace, with the given members, and returns
+// its ID. If the channel's ID is empty, the server generates one. If none of its
+// type flags are set, it's a public channel.
+func (s *Server) AddChannel(ch slack.Channel, members ...string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addChannel(ch, members).info.ID
+}
+
+// AddUser adds a user to the workspace, or replaces an existing user with the same ID.
+func (s *Server) AddUser(u slack.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if u.TeamID == "" {
+		u.TeamID = TeamID
+	}
+	if i := slices.IndexFunc(s.users, func(x *slack.User) bool { return x.ID == u.ID }); i >= 0 {
+		s.users[i] = &u
+		return
+	}
+	s.users = append(s.users, &u)
+}
+
+// AddMessage adds a message to a channel, and returns its timestamp. If the
+// message's timestamp is empty, the server generates one. Otherwise, timestamps
+// which the server generates later are greater than it. It returns an empty
+// string if the channel doesn't exist.
+func (s *Server) AddMessage(channelID string, m slack.Message) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.channel(channelID)
+	if c == nil {
+		return ""
+	}
+
+	if m.Type == "" {
+		m.Type = "message"
+	}
+	if m.TS == "" {
+		m.TS = s.nextTS()
+	} else {
+		s.observeTS(m.TS)
+	}
+	c.addMessage(&m)
+	return m.TS
+}
+
+// Channel returns the current state of a channel in the workspace.
+func (s *Server) Channel(id string) (slack.Channel, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.channel(id)
+	if c == nil {
+		return slack.Channel{}, false
+	}
+	return c.view(""), true
+}
+
+// Members returns the IDs of the current members of a channel in the workspace.
+func (s *Server) Members(channelID string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c := s.channel(channelID); c != nil {
+		return slices.Clone(c.members)
+	}
+	return nil
+}
+
+// Messages returns the current messages in a channel, including thread replies,
+// sorted by their timestamps (i.e. oldest first).
+func (s *Server) Messages(channelID string) []slack.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.channel(channelID)
+	if c == nil {
+		return nil
+	}
+
+	msgs := make([]slack.Message, 0, len(c.messages))
+	for _, m := range c.messages {
+		msgs = append(msgs, copyMessage(m))
+	}
+	return msgs
+}
+
+// InjectError makes the next call to an API method (e.g. "chat.postMessage" or
+// [slack.ChatPostMessageName]) fail with the given Slack error code, before any
+// other processing. Multiple injected errors are returned in order, one per call.
+// The "ratelimited" error code is returned with an HTTP 429 status code and
+// a "Retry-After" header, like Slack does; all others with HTTP 200.
+func (s *Server) InjectError(method, code string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	method = strings.TrimPrefix(method, "slack.")
+	s.errors[method] = append(s.errors[method], code)
+}
+
+// Calls returns the number of requests that the server received so far for an API
+// method (e.g. "chat.postMessage" or [slack.ChatPostMessageName]), including
+// failed ones.
+func (s *Server) Calls(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.calls[strings.TrimPrefix(method, "slack.")]
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	method, ok := strings.CutPrefix(r.URL.Path, "/api/")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls[method]++
+	if codes := s.errors[method]; len(codes) > 0 {
+		s.errors[method] = codes[1:]
+		writeError(w, codes[0])
+		return
+	}
+
+	h, ok := handlers[method]
+	if !ok {
+		writeError(w, "unknown_method")
+		return
+	}
+
+	caller, code := authenticate(r)
+	if code != "" {
+		writeError(w, code)
+		return
+	}
+
+	p, err := parseParams(r)
+	if err != nil {
+		writeError(w, "invalid_json")
+		return
+	}
+
+	resp, code := h(s, caller, p)
+	if code != "" {
+		writeError(w, code)
+		return
+	}
+
+	if resp == nil {
+		resp = response{}
+	}
+	resp["ok"] = true
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// authenticate returns the ID of the user which corresponds to the request's
+// bearer token, or a Slack error code if the token is missing or invalid.
+func authenticate(r *http.Request) (string, string) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	switch {
+	case !ok || token == "":
+		return "", "not_authed"
+	case token == BotToken:
+		return BotUserID, ""
+	case token == UserToken:
+		return UserID, ""
+	default:
+		return "", "invalid_auth"
+	}
+}
+
+// parseParams returns the parameters of an API call: query parameters,
+// and the fields of a JSON or URL-encoded form body in POST requests.
+func parseParams(r *http.Request) (params, error) {
+	p := params{}
+	for k, v := range r.URL.Query() {
+		p[k] = v[0]
+	}
+
+	if r.Method != http.MethodPost {
+		return p, nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		body := map[string]any{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		maps.Copy(p, body)
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			return nil, err
+		}
+		for k, v := range r.PostForm {
+			p[k] = v[0]
+		}
+	}
+
+	return p, nil
+}
+
+func writeError(w http.ResponseWriter, code string) {
+	status := http.StatusOK
+	if code == "ratelimited" {
+		w.Header().Set("Retry-After", strconv.Itoa(RetryAfter))
+		status = http.StatusTooManyRequests
+	}
+	writeJSON(w, status, response{"ok": false, "error": code})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// str returns a parameter as a string, regardless of its JSON type.
+func (p params) str(key string) string {
+	switch v := p[key].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return ""
+	}
+}
+
+func (p params) bool(key string) bool {
+	b, _ := strconv.ParseBool(p.str(key))
+	return b
+}
+
+func (p params) int(key string) int64 {
+	n, _ := strconv.ParseInt(p.str(key), 10, 64)
+	return n
+}
+
+// list returns a comma-separated string parameter as a slice.
+func (p params) list(key string) []string {
+	var l []string
+	for s := range strings.SplitSeq(p.str(key), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			l = append(l, s)
+		}
+	}
+	return l
+}
+
+// decode decodes a structured parameter (e.g. "blocks") into v. String values
+// (in query parameters or URL-encoded forms) are decoded as JSON. It returns
+// false if the parameter is missing or can't be decoded.
+func (p params) decode(key string, v any) bool {
+	raw, ok := p[key]
+	if !ok || raw == nil {
+		return false
+	}
+
+	var b []byte
+	if s, ok := raw.(string); ok {
+		b = []byte(s)
+	} else {
+		var err error
+		if b, err = json.Marshal(raw); err != nil {
+			return false
+		}
+	}
+
+	return json.Unmarshal(b, v) == nil
+}
+
+// paginate returns a page of items based on the "cursor" and "limit" parameters,
+// and the cursor of the next page, which is empty if this is the last one.
+func paginate[T any](items []T, p params) (page []T, next, code string) {
+	start := 0
+	if c := p.str("cursor"); c != "" {
+		b, err := base64.StdEncoding.DecodeString(c)
+		offset, ok := strings.CutPrefix(string(b), cursorPrefix)
+		n, err2 := strconv.Atoi(offset)
+		if err != nil || !ok || err2 != nil || n < 0 || n > len(items) {
+			return nil, "", "invalid_cursor"
+		}
+		start = n
+	}
+
+	limit := int(p.int("limit"))
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+
+	end := min(start+limit, len(items))
+	if end < len(items) {
+		next = base64.StdEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(end)))
+	}
+
+	return items[start:end], next, ""
+}
+
+// withCursor adds the cursor of the next page to a paginated response.
+func withCursor(resp response, next string) response {
+	resp["response_metadata"] = map[string]string{"next_cursor": next}
+	return resp
+}
+
+// nextID returns a new unique ID with the given prefix, e.g. "C" for channels.
+func (s *Server) nextID(prefix string) string {
+	s.lastID++
+	return fmt.Sprintf("%s%09d", prefix, s.lastID)
+}
+
+// nextTS returns a new unique message timestamp, which is greater
+// than all the existing ones (including those in [Server.AddMessage]).
+func (s *Server) nextTS() string {
+	s.lastTS = max(s.lastTS, firstTS) + 1
+	return fmt.Sprintf("%d.%06d", s.lastTS, 0)
+}
+
+// observeTS ensures that [Server.nextTS] continues after an existing message timestamp.
+func (s *Server) observeTS(ts string) {
+	secs, _, _ := strings.Cut(ts, ".")
+	if n, err := strconv.ParseInt(secs, 10, 64); err == nil {
+		s.lastTS = max(s.lastTS, n)
+	}
+}
+
+func (s *Server) addChannel(ch slack.Channel, members []string) *channel {
+	if ch.ID == "" {
+		prefix := "C"
+		if ch.IsIM {
+			prefix = "D"
+		}
+		ch.ID = s.nextID(prefix)
+	}
+	if !ch.IsChannel && !ch.IsGroup && !ch.IsIM && !ch.IsMPIM {
+		ch.IsChannel = true
+	}
+	if ch.Name != "" && ch.NameNormalized == "" {
+		ch.NameNormalized = ch.Name
+	}
+	if ch.ContextTeamID == "" && !ch.IsIM {
+		ch.ContextTeamID = TeamID
+	}
+
+	c := &channel{info: ch, members: slices.Clone(members)}
+	s.channels = append(s.channels, c)
+	return c
+}
+
+// channel returns a channel by its ID, or nil if it doesn't exist.
+func (s *Server) channel(id string) *channel {
+	i := slices.IndexFunc(s.channels, func(c *channel) bool { return c.info.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return s.channels[i]
+}
+
+// user returns a user by its ID, or nil if it doesn't exist.
+func (s *Server) user(id string) *slack.User {
+	i := slices.IndexFunc(s.users, func(u *slack.User) bool { return u.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return s.users[i]
+}
+
+// view returns a copy of the channel's info, from the perspective of the given user.
+func (c *channel) view(caller string) slack.Channel {
+	ch := c.info
+	ch.IsMember = c.isMember(caller)
+	ch.PreviousNames = slices.Clone(ch.PreviousNames)
+	if !ch.IsIM {
+		ch.NumMembers = len(c.members)
+	}
+	if c.canvas != "" {
+		ch.Extra = maps.Clone(ch.Extra)
+		if ch.Extra == nil {
+			ch.Extra = map[string]any{}
+		}
+		ch.Extra["properties"] = map[string]any{"canvas": map[string]any{"file_id": c.canvas}}
+	}
+	return ch
+}
+
+func (c *channel) isMember(user string) bool {
+	return slices.Contains(c.members, user)
+}
+
+func (c *channel) addMessage(m *slack.Message) {
+	c.messages = append(c.messages, m)
+	slices.SortStableFunc(c.messages, func(a, b *slack.Message) int {
+		return compareTS(a.TS, b.TS)
+	})
+}
+
+// message returns a message in the channel by its timestamp, or nil if it doesn't exist.
+func (c *channel) message(ts string) *slack.Message {
+	i := slices.IndexFunc(c.messages, func(m *slack.Message) bool { return m.TS == ts })
+	if i < 0 {
+		return nil
+	}
+	return c.messages[i]
+}
+
+func compareTS(a, b string) int {
+	x, _ := strconv.ParseFloat(a, 64)
+	y, _ := strconv.ParseFloat(b, 64)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// copyMessage returns a copy of a message, which doesn't share mutable state with it.
+func copyMessage(m *slack.Message) slack.Message {
+	c := *m
+	c.ReplyUsers = slices.Clone(m.ReplyUsers)
+	c.Reactions = slices.Clone(m.Reactions)
+	for i, r := range c.Reactions {
+		c.Reactions[i].Users = slices.Clone(r.Users)
+	}
+	return c
+}
@@ -0,0 +1,306 @@
+package slacktest_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/tzrikka/ovid/pkg/slack"
+	"github.com/tzrikka/ovid/pkg/slack/slacktest"
+)
+
+func TestChat(t *testing.T) {
+	s := slacktest.NewServer()
+	defer s.Close()
+	a := s.API(nil)
+	ch := s.AddChannel(slack.Channel{Name: "general"}, slacktest.BotUserID, slacktest.UserID)
+
+	post, err := a.ChatPostMessageActivity(t.Context(), &slack.ChatPostMessageRequest{Channel: ch, Text: "parent"})
+	if err != nil {
+		t.Fatalf("ChatPostMessageActivity() error = %v", err)
+	}
+	if post.Message.User != slacktest.BotUserID {
+		t.Errorf("ChatPostMessageActivity() user = %q, want %q", post.Message.User, slacktest.BotUserID)
+	}
+
+	reply, err := a.ChatPostMessageActivity(t.Context(), &slack.ChatPostMessageRequest{Channel: ch, Text: "reply", ThreadTS: post.TS})
+	if err != nil {
+		t.Fatalf("ChatPostMessageActivity() error = %v", err)
+	}
+
+	if _, err := a.ChatUpdateActivity(t.Context(), &slack.ChatUpdateRequest{Channel: ch, TS: reply.TS, Text: "edited"}); err != nil {
+		t.Fatalf("ChatUpdateActivity() error = %v", err)
+	}
+
+	hist, err := a.ConversationsHistoryActivity(t.Context(), &slack.ConversationsHistoryRequest{Channel: ch})
+	if err != nil {
+		t.Fatalf("ConversationsHistoryActivity() error = %v", err)
+	}
+	if len(hist.Messages) != 1 || hist.Messages[0].ReplyCount != 1 {
+		t.Errorf("ConversationsHistoryActivity() = %+v, want 1 parent message with 1 reply", hist.Messages)
+	}
+
+	replies, err := a.ConversationsRepliesActivity(t.Context(), &slack.ConversationsRepliesRequest{Channel: ch, TS: post.TS})
+	if err != nil {
+		t.Fatalf("ConversationsRepliesActivity() error = %v", err)
+	}
+	if len(replies.Messages) != 2 || replies.Messages[1].Text != "edited" || replies.Messages[1].Edited == nil {
+		t.Errorf("ConversationsRepliesActivity() = %+v, want parent and edited reply", replies.Messages)
+	}
+
+	if _, err := a.ChatDeleteActivity(t.Context(), &slack.ChatDeleteRequest{Channel: ch, TS: reply.TS}); err != nil {
+		t.Fatalf("ChatDeleteActivity() error = %v", err)
+	}
+	if got := s.Messages(ch); len(got) != 1 || got[0].ReplyCount != 0 {
+		t.Errorf("Server.Messages() = %+v, want only the parent without replies", got)
+	}
+}
+
+func TestConversations(t *testing.T) {
+	s := slacktest.NewServer()
+	defer s.Close()
+	a := s.API(nil)
+	s.AddUser(slack.User{ID: "U1", Name: "alice"})
+
+	created, err := a.ConversationsCreateActivity(t.Context(), &slack.ConversationsCreateRequest{Name: "project", IsPrivate: true})
+	if err != nil {
+		t.Fatalf("ConversationsCreateActivity() error = %v", err)
+	}
+	ch := created.Channel.ID
+
+	if _, err := a.ConversationsInviteActivity(t.Context(), &slack.ConversationsInviteRequest{Channel: ch, Users: "U1"}); err != nil {
+		t.Fatalf("ConversationsInviteActivity() error = %v", err)
+	}
+	if _, err := a.ConversationsSetTopicActivity(t.Context(), &slack.ConversationsSetTopicRequest{Channel: ch, Topic: "topic"}); err != nil {
+		t.Fatalf("ConversationsSetTopicActivity() error = %v", err)
+	}
+	if _, err := a.ConversationsRenameActivity(t.Context(), &slack.ConversationsRenameRequest{Channel: ch, Name: "renamed"}); err != nil {
+		t.Fatalf("ConversationsRenameActivity() error = %v", err)
+	}
+
+	members, err := a.ConversationsMembersAllActivity(t.Context(), &slack.ConversationsMembersAllRequest{
+		ConversationsMembersRequest: slack.ConversationsMembersRequest{Channel: ch, Limit: 1},
+	})
+	if err != nil {
+		t.Fatalf("ConversationsMembersAllActivity() error = %v", err)
+	}
+	if want := []string{slacktest.BotUserID, "U1"}; !slices.Equal(members.Members, want) {
+		t.Errorf("ConversationsMembersAllActivity() = %q, want %q", members.Members, want)
+	}
+
+	list, err := a.UsersConversationsActivity(t.Context(), &slack.UsersConversationsRequest{Types: "private_channel"})
+	if err != nil {
+		t.Fatalf("UsersConversationsActivity() error = %v", err)
+	}
+	if len(list.Channels) != 1 || list.Channels[0].Name != "renamed" || list.Channels[0].Topic.Value != "topic" {
+		t.Errorf("UsersConversationsActivity() = %+v", list.Channels)
+	}
+
+	canvas, err := a.ConversationsCanvasesCreateActivity(t.Context(), &slack.ConversationsCanvasesCreateRequest{ChannelID: ch, Title: "title"})
+	if err != nil {
+		t.Fatalf("ConversationsCanvasesCreateActivity() error = %v", err)
+	}
+	info, err := a.ConversationsInfoActivity(t.Context(), &slack.ConversationsInfoRequest{Channel: ch})
+	if err != nil {
+		t.Fatalf("ConversationsInfoActivity() error = %v", err)
+	}
+	props, _ := info.Channel.Extra["properties"].(map[string]any)
+	if c, _ := props["canvas"].(map[string]any); c["file_id"] != canvas.CanvasID {
+		t.Errorf("ConversationsInfoActivity() properties = %v, want canvas %q", props, canvas.CanvasID)
+	}
+	_, err = a.ConversationsCanvasesCreateActivity(t.Context(), &slack.ConversationsCanvasesCreateRequest{ChannelID: ch})
+	assertSlackError(t, err, "channel_canvas_already_exists", false)
+
+	if _, err := a.ConversationsArchiveActivity(t.Context(), &slack.ConversationsArchiveRequest{Channel: ch}); err != nil {
+		t.Fatalf("ConversationsArchiveActivity() error = %v", err)
+	}
+	if got, _ := s.Channel(ch); !got.IsArchived || !slices.Equal(got.PreviousNames, []string{"project"}) {
+		t.Errorf("Server.Channel() = %+v", got)
+	}
+
+	_, err = a.ChatPostMessageActivity(t.Context(), &slack.ChatPostMessageRequest{Channel: ch, Text: "text"})
+	assertSlackError(t, err, "is_archived", false)
+}
+
+func TestAddMessageTimestamps(t *testing.T) {
+	s := slacktest.NewServer()
+	defer s.Close()
+	a := s.API(nil)
+	ch := s.AddChannel(slack.Channel{Name: "general"}, slacktest.BotUserID)
+
+	fixture := s.AddMessage(ch, slack.Message{User: slacktest.UserID, Text: "fixture", TS: "1800000000.000001"})
+	post, err := a.ChatPostMessageActivity(t.Context(), &slack.ChatPostMessageRequest{Channel: ch, Text: "posted"})
+	if err != nil {
+		t.Fatalf("ChatPostMessageActivity() error = %v", err)
+	}
+	if post.TS <= fixture {
+		t.Errorf("ChatPostMessageActivity() timestamp = %q, want greater than %q", post.TS, fixture)
+	}
+
+	got := s.Messages(ch)
+	if len(got) != 2 || got[0].Text != "fixture" || got[1].Text != "posted" {
+		t.Errorf("Server.Messages() = %+v, want fixture before posted message", got)
+	}
+}
+
+func TestReactions(t *testing.T) {
+	s := slacktest.NewServer()
+	defer s.Close()
+	a := s.API(nil)
+	ch := s.AddChannel(slack.Channel{Name: "general"}, slacktest.BotUserID)
+	ts := s.AddMessage(ch, slack.Message{User: slacktest.UserID, Text: "text"})
+
+	req := &slack.ReactionsAddRequest{Channel: ch, Name: "eyes", Timestamp: ts}
+	if _, err := a.ReactionsAddActivity(t.Context(), req); err != nil {
+		t.Fatalf("ReactionsAddActivity() error = %v", err)
+	}
+	_, err := a.ReactionsAddActivity(t.Context(), req)
+	assertSlackError(t, err, "already_reacted", false)
+
+	got, err := a.ReactionsGetActivity(t.Context(), &slack.ReactionsGetRequest{Channel: ch, Timestamp: ts})
+	if err != nil {
+		t.Fatalf("ReactionsGetActivity() error = %v", err)
+	}
+	want := []slack.Reaction{{Name: "eyes", Count: 1, Users: []string{slacktest.BotUserID}}}
+	if len(got.Message.Reactions) != 1 || got.Message.Reactions[0].Count != 1 || got.Message.Reactions[0].Name != "eyes" {
+		t.Errorf("ReactionsGetActivity() reactions = %+v, want %+v", got.Message.Reactions, want)
+	}
+
+	if _, err := a.ReactionsRemoveActivity(t.Context(), &slack.ReactionsRemoveRequest{Channel: ch, Name: "eyes", Timestamp: ts}); err != nil {
+		t.Fatalf("ReactionsRemoveActivity() error = %v", err)
+	}
+	if msgs := s.Messages(ch); len(msgs[0].Reactions) != 0 {
+		t.Errorf("Server.Messages() reactions = %+v, want none", msgs[0].Reactions)
+	}
+}
+
+func TestUsers(t *testing.T) {
+	s := slacktest.NewServer()
+	defer s.Close()
+	a := s.API(nil)
+
+	id, err := a.UsersIdentityActivity(t.Context(), &slack.UsersIdentityRequest{})
+	if err != nil {
+		t.Fatalf("UsersIdentityActivity() error = %v", err)
+	}
+	if id.User.ID != slacktest.UserID {
+		t.Errorf("UsersIdentityActivity() user = %q, want %q", id.User.ID, slacktest.UserID)
+	}
+
+	if _, err := a.UsersProfileSetActivity(t.Context(), &slack.UsersProfileSetRequest{Name: "title", Value: "Tester"}); err != nil {
+		t.Fatalf("UsersProfileSetActivity() error = %v", err)
+	}
+
+	u, err := a.UsersLookupByEmailActivity(t.Context(), &slack.UsersLookupByEmailRequest{Email: "slacktest@example.com"})
+	if err != nil {
+		t.Fatalf("UsersLookupByEmailActivity() error = %v", err)
+	}
+	if u.User.Profile.Title != "Tester" || u.User.Profile.RealName != "Slack Test" {
+		t.Errorf("UsersLookupByEmailActivity() profile = %+v", u.User.Profile)
+	}
+
+	if _, err := a.UsersSetPresenceActivity(t.Context(), &slack.UsersSetPresenceRequest{Presence: "away"}); err != nil {
+		t.Fatalf("UsersSetPresenceActivity() error = %v", err)
+	}
+	p, err := a.UsersGetPresenceActivity(t.Context(), &slack.UsersGetPresenceRequest{User: slacktest.UserID})
+	if err != nil {
+		t.Fatalf("UsersGetPresenceActivity() error = %v", err)
+	}
+	if p.Presence != "away" {
+		t.Errorf("UsersGetPresenceActivity() = %q, want %q", p.Presence, "away")
+	}
+}
+
+func TestInjectError(t *testing.T) {
+	s := slacktest.NewServer()
+	defer s.Close()
+	a := s.API(nil)
+	ch := s.AddChannel(slack.Channel{Name: "general"})
+
+	s.InjectError(slack.ChatPostMessageName, "ratelimited")
+	s.InjectError("chat.postMessage", "channel_not_found")
+	req := &slack.ChatPostMessageRequest{Channel: ch, Text: "text"}
+
+	_, err := a.ChatPostMessageActivity(t.Context(), req)
+	appErr := new(temporal.ApplicationError)
+	if !errors.As(err, &appErr) {
+		t.Fatalf("ChatPostMessageActivity() error = %v, want *temporal.ApplicationError", err)
+	}
+	if appErr.NonRetryable() || appErr.NextRetryDelay() != slacktest.RetryAfter*time.Second {
+		t.Errorf("ChatPostMessageActivity() error = %v, want retryable after %ds", err, slacktest.RetryAfter)
+	}
+
+	_, err = a.ChatPostMessageActivity(t.Context(), req)
+	assertSlackError(t, err, "channel_not_found", false)
+
+	if _, err := a.ChatPostMessageActivity(t.Context(), req); err != nil {
+		t.Errorf("ChatPostMessageActivity() error = %v", err)
+	}
+	if got := s.Calls(slack.ChatPostMessageName); got != 3 {
+		t.Errorf("Server.Calls() = %d, want 3", got)
+	}
+}
+
+func TestWorkflow(t *testing.T) {
+	s := slacktest.NewServer()
+	defer s.Close()
+	ch := s.AddChannel(slack.Channel{Name: "general"})
+
+	postWorkflow := func(ctx workflow.Context, channel string) (string, error) {
+		ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+		req := &slack.ChatPostMessageRequest{Channel: channel, Text: "hello"}
+		resp := new(slack.ChatPostMessageResponse)
+		if err := workflow.ExecuteActivity(ctx, slack.ChatPostMessageName, req).Get(ctx, resp); err != nil {
+			return "", err
+		}
+		return resp.TS, nil
+	}
+
+	var ts testsuite.WorkflowTestSuite
+	env := ts.NewTestWorkflowEnvironment()
+	env.RegisterWorkflow(postWorkflow)
+	s.API(nil).RegisterActivities(env)
+
+	env.ExecuteWorkflow(postWorkflow, ch)
+	if err := env.GetWorkflowError(); err != nil {
+		t.Fatalf("workflow error = %v", err)
+	}
+
+	var got string
+	if err := env.GetWorkflowResult(&got); err != nil {
+		t.Fatal(err)
+	}
+	if msgs := s.Messages(ch); len(msgs) != 1 || msgs[0].TS != got || msgs[0].Text != "hello" {
+		t.Errorf("Server.Messages() = %+v, want 1 message with TS %q", msgs, got)
+	}
+
+	// Non-retryable Slack errors fail the workflow.
+	env = ts.NewTestWorkflowEnvironment()
+	env.RegisterWorkflow(postWorkflow)
+	s.API(nil).RegisterActivities(env)
+
+	env.ExecuteWorkflow(postWorkflow, "C_MISSING")
+	if err := env.GetWorkflowError(); err == nil {
+		t.Error("workflow error = nil, want channel_not_found")
+	}
+}
+
+func assertSlackError(t *testing.T, err error, code string, retryable bool) {
+	t.Helper()
+
+	appErr := new(temporal.ApplicationError)
+	if !errors.As(err, &appErr) {
+		t.Fatalf("error = %v, want *temporal.ApplicationError", err)
+	}
+	if appErr.Type() != code {
+		t.Errorf("error type = %q, want %q", appErr.Type(), code)
+	}
+	if appErr.NonRetryable() == retryable {
+		t.Errorf("error retryable = %t, want %t", !appErr.NonRetryable(), retryable)
+	}
+}
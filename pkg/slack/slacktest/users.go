@@ -0,0 +1,186 @@
+package slacktest
+
+import (
+	"encoding/json"
+	"maps"
+
+	"github.com/tzrikka/ovid/pkg/slack"
+)
+
+// https://docs.slack.dev/reference/methods/users.conversations
+func usersConversations(s *Server, caller string, p params) (response, string) {
+	user := p.str("user")
+	if user == "" {
+		user = caller
+	}
+	if s.user(user) == nil {
+		return nil, "user_not_found"
+	}
+
+	return s.listChannels(caller, p, func(c *channel) bool { return c.isMember(user) })
+}
+
+// https://docs.slack.dev/reference/methods/users.getPresence
+func usersGetPresence(s *Server, caller string, p params) (response, string) {
+	user := p.str("user")
+	if user == "" {
+		user = caller
+	}
+	if s.user(user) == nil {
+		return nil, "user_not_found"
+	}
+
+	presence := s.presence[user]
+	if presence == "" {
+		presence = "active"
+	}
+
+	resp := response{"presence": presence}
+	if user == caller {
+		resp["online"] = presence == "active"
+		resp["manual_away"] = presence == "away"
+	}
+	return resp, ""
+}
+
+// https://docs.slack.dev/reference/methods/users.identity
+func usersIdentity(s *Server, caller string, _ params) (response, string) {
+	if caller == BotUserID {
+		return nil, "not_allowed_token_type"
+	}
+
+	u := s.user(caller)
+	return response{
+		"user": slack.User{ID: u.ID, Name: u.Name},
+		"team": slack.Team{ID: TeamID},
+	}, ""
+}
+
+// https://docs.slack.dev/reference/methods/users.info
+func usersInfo(s *Server, _ string, p params) (response, string) {
+	u := s.user(p.str("user"))
+	if u == nil {
+		return nil, "user_not_found"
+	}
+
+	return response{"user": *u}, ""
+}
+
+// https://docs.slack.dev/reference/methods/users.list
+func usersList(s *Server, _ string, p params) (response, string) {
+	users := make([]slack.User, 0, len(s.users))
+	for _, u := range s.users {
+		users = append(users, *u)
+	}
+
+	page, next, code := paginate(users, p)
+	if code != "" {
+		return nil, code
+	}
+	return withCursor(response{"members": page}, next), ""
+}
+
+// https://docs.slack.dev/reference/methods/users.lookupByEmail
+func usersLookupByEmail(s *Server, _ string, p params) (response, string) {
+	email := p.str("email")
+	for _, u := range s.users {
+		if u.Profile != nil && u.Profile.Email == email && email != "" {
+			return response{"user": *u}, ""
+		}
+	}
+
+	return nil, "users_not_found"
+}
+
+// https://docs.slack.dev/reference/methods/users.profile.get
+func usersProfileGet(s *Server, caller string, p params) (response, string) {
+	user := p.str("user")
+	if user == "" {
+		user = caller
+	}
+
+	u := s.user(user)
+	if u == nil {
+		return nil, "user_not_found"
+	}
+
+	prof := u.Profile
+	if prof == nil {
+		prof = &slack.Profile{}
+	}
+	return response{"profile": prof}, ""
+}
+
+// https://docs.slack.dev/reference/methods/users.profile.set
+func usersProfileSet(s *Server, caller string, p params) (response, string) {
+	user := p.str("user")
+	if user == "" {
+		user = caller
+	}
+
+	u := s.user(user)
+	if u == nil {
+		return nil, "user_not_found"
+	}
+	if user != caller && !s.user(caller).IsAdmin {
+		return nil, "not_admin"
+	}
+
+	fields := map[string]any{}
+	p.decode("profile", &fields)
+	if name := p.str("name"); name != "" {
+		fields[name] = p.str("value")
+	}
+	if len(fields) == 0 {
+		return nil, "invalid_profile"
+	}
+
+	prof, err := mergeProfile(u.Profile, fields)
+	if err != nil {
+		return nil, "invalid_profile"
+	}
+
+	u.Profile = prof
+	return response{"profile": prof}, ""
+}
+
+// https://docs.slack.dev/reference/methods/users.setPresence
+func usersSetPresence(s *Server, caller string, p params) (response, string) {
+	switch p.str("presence") {
+	case "auto":
+		delete(s.presence, caller)
+	case "away":
+		s.presence[caller] = "away"
+	default:
+		return nil, "invalid_presence"
+	}
+
+	return nil, ""
+}
+
+// mergeProfile returns a copy of a user profile, with the given fields
+// overriding its existing ones, including custom fields (in Extra).
+func mergeProfile(prof *slack.Profile, fields map[string]any) (*slack.Profile, error) {
+	m := map[string]any{}
+	if prof != nil {
+		b, err := json.Marshal(prof)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &m); err != nil {
+			return nil, err
+		}
+	}
+	maps.Copy(m, fields)
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := new(slack.Profile)
+	if err := json.Unmarshal(b, merged); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
@@ -0,0 +1,85 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+)
+
+const (
+	TeamInfoName       = "slack.team.info"
+	TeamProfileGetName = "slack.team.profile.get"
+)
+
+// https://docs.slack.dev/reference/methods/team.info
+type TeamInfoRequest struct {
+	CallOptions
+
+	Domain string `json:"domain,omitempty"`
+	Team   string `json:"team,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/team.info
+type TeamInfoResponse struct {
+	slackResponse
+
+	Team *Team `json:"team,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/team.info
+func (a *API) TeamInfoActivity(ctx context.Context, req *TeamInfoRequest) (*TeamInfoResponse, error) {
+	query := url.Values{}
+	if req.Domain != "" {
+		query.Set("domain", req.Domain)
+	}
+	if req.Team != "" {
+		query.Set("team", req.Team)
+	}
+
+	resp := new(TeamInfoResponse)
+	if err := a.httpGet(ctx, TeamInfoName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/team.profile.get
+type TeamProfileGetRequest struct {
+	CallOptions
+
+	Visibility string `json:"visibility,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/team.profile.get
+type TeamProfileGetResponse struct {
+	slackResponse
+
+	Profile *TeamProfile `json:"profile,omitempty"`
+}
+
+// TeamProfile describes the custom fields in the user profiles of a Slack workspace.
+//
+// https://docs.slack.dev/reference/methods/team.profile.get
+type TeamProfile struct {
+	Fields   []map[string]any `json:"fields,omitempty"`
+	Sections []map[string]any `json:"sections,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/team.profile.get
+func (a *API) TeamProfileGetActivity(ctx context.Context, req *TeamProfileGetRequest) (*TeamProfileGetResponse, error) {
+	query := url.Values{}
+	if req.Visibility != "" {
+		query.Set("visibility", req.Visibility)
+	}
+
+	resp := new(TeamProfileGetResponse)
+	if err := a.httpGet(ctx, TeamProfileGetName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
@@ -0,0 +1,307 @@
+package slack
+
+import (
+	"context"
+	"net/url"
+)
+
+const (
+	UsergroupsCreateName      = "slack.usergroups.create"
+	UsergroupsDisableName     = "slack.usergroups.disable"
+	UsergroupsEnableName      = "slack.usergroups.enable"
+	UsergroupsListName        = "slack.usergroups.list"
+	UsergroupsUpdateName      = "slack.usergroups.update"
+	UsergroupsUsersListName   = "slack.usergroups.users.list"
+	UsergroupsUsersUpdateName = "slack.usergroups.users.update"
+)
+
+// UserGroup is a Slack user group object. Fields which aren't
+// defined explicitly in this struct are preserved in Extra.
+//
+// https://docs.slack.dev/reference/objects/usergroup-object
+type UserGroup struct {
+	ID          string `json:"id"`
+	TeamID      string `json:"team_id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Handle      string `json:"handle,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	IsUsergroup bool   `json:"is_usergroup,omitempty"`
+	IsExternal  bool   `json:"is_external,omitempty"`
+	AutoType    string `json:"auto_type,omitempty"`
+
+	DateCreate int64  `json:"date_create,omitempty"`
+	DateUpdate int64  `json:"date_update,omitempty"`
+	DateDelete int64  `json:"date_delete,omitempty"`
+	CreatedBy  string `json:"created_by,omitempty"`
+	UpdatedBy  string `json:"updated_by,omitempty"`
+	DeletedBy  string `json:"deleted_by,omitempty"`
+
+	Prefs     *UserGroupPrefs `json:"prefs,omitempty"`
+	Users     []string        `json:"users,omitempty"`
+	UserCount int             `json:"user_count,omitempty"`
+
+	Extra map[string]any `json:"-"`
+}
+
+// https://docs.slack.dev/reference/objects/usergroup-object
+type UserGroupPrefs struct {
+	Channels []string `json:"channels,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+func (g *UserGroup) UnmarshalJSON(b []byte) error {
+	type userGroup UserGroup
+	extra, err := unmarshalWithExtra(b, (*userGroup)(g))
+	g.Extra = extra
+	return err
+}
+
+func (g UserGroup) MarshalJSON() ([]byte, error) {
+	type userGroup UserGroup
+	return marshalWithExtra(userGroup(g), g.Extra)
+}
+
+// UsergroupsCreateRequest's Channels is a comma-separated
+// list of default channel IDs for the user group.
+//
+// https://docs.slack.dev/reference/methods/usergroups.create
+type UsergroupsCreateRequest struct {
+	CallOptions
+
+	Name string `json:"name"`
+
+	AdditionalChannels string `json:"additional_channels,omitempty"`
+	Channels           string `json:"channels,omitempty"`
+	Description        string `json:"description,omitempty"`
+	Handle             string `json:"handle,omitempty"`
+	IncludeCount       bool   `json:"include_count,omitempty"`
+	TeamID             string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.create
+type UsergroupsCreateResponse struct {
+	slackResponse
+
+	Usergroup *UserGroup `json:"usergroup,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.create
+func (a *API) UsergroupsCreateActivity(ctx context.Context, req *UsergroupsCreateRequest) (*UsergroupsCreateResponse, error) {
+	resp := new(UsergroupsCreateResponse)
+	if err := a.httpPost(ctx, UsergroupsCreateName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.disable
+type UsergroupsDisableRequest struct {
+	CallOptions
+
+	Usergroup string `json:"usergroup"`
+
+	IncludeCount bool   `json:"include_count,omitempty"`
+	TeamID       string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.disable
+type UsergroupsDisableResponse struct {
+	slackResponse
+
+	Usergroup *UserGroup `json:"usergroup,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.disable
+func (a *API) UsergroupsDisableActivity(ctx context.Context, req *UsergroupsDisableRequest) (*UsergroupsDisableResponse, error) {
+	resp := new(UsergroupsDisableResponse)
+	if err := a.httpPost(ctx, UsergroupsDisableName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.enable
+type UsergroupsEnableRequest struct {
+	CallOptions
+
+	Usergroup string `json:"usergroup"`
+
+	IncludeCount bool   `json:"include_count,omitempty"`
+	TeamID       string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.enable
+type UsergroupsEnableResponse struct {
+	slackResponse
+
+	Usergroup *UserGroup `json:"usergroup,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.enable
+func (a *API) UsergroupsEnableActivity(ctx context.Context, req *UsergroupsEnableRequest) (*UsergroupsEnableResponse, error) {
+	resp := new(UsergroupsEnableResponse)
+	if err := a.httpPost(ctx, UsergroupsEnableName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.list
+type UsergroupsListRequest struct {
+	CallOptions
+
+	IncludeCount    bool   `json:"include_count,omitempty"`
+	IncludeDisabled bool   `json:"include_disabled,omitempty"`
+	IncludeUsers    bool   `json:"include_users,omitempty"`
+	TeamID          string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.list
+type UsergroupsListResponse struct {
+	slackResponse
+
+	Usergroups []UserGroup `json:"usergroups,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.list
+func (a *API) UsergroupsListActivity(ctx context.Context, req *UsergroupsListRequest) (*UsergroupsListResponse, error) {
+	query := url.Values{}
+	if req.IncludeCount {
+		query.Set("include_count", "true")
+	}
+	if req.IncludeDisabled {
+		query.Set("include_disabled", "true")
+	}
+	if req.IncludeUsers {
+		query.Set("include_users", "true")
+	}
+	if req.TeamID != "" {
+		query.Set("team_id", req.TeamID)
+	}
+
+	resp := new(UsergroupsListResponse)
+	if err := a.httpGet(ctx, UsergroupsListName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.update
+type UsergroupsUpdateRequest struct {
+	CallOptions
+
+	Usergroup string `json:"usergroup"`
+
+	AdditionalChannels string `json:"additional_channels,omitempty"`
+	Channels           string `json:"channels,omitempty"`
+	Description        string `json:"description,omitempty"`
+	Handle             string `json:"handle,omitempty"`
+	IncludeCount       bool   `json:"include_count,omitempty"`
+	Name               string `json:"name,omitempty"`
+	TeamID             string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.update
+type UsergroupsUpdateResponse struct {
+	slackResponse
+
+	Usergroup *UserGroup `json:"usergroup,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.update
+func (a *API) UsergroupsUpdateActivity(ctx context.Context, req *UsergroupsUpdateRequest) (*UsergroupsUpdateResponse, error) {
+	resp := new(UsergroupsUpdateResponse)
+	if err := a.httpPost(ctx, UsergroupsUpdateName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.users.list
+type UsergroupsUsersListRequest struct {
+	CallOptions
+
+	Usergroup string `json:"usergroup"`
+
+	IncludeDisabled bool   `json:"include_disabled,omitempty"`
+	TeamID          string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.users.list
+type UsergroupsUsersListResponse struct {
+	slackResponse
+
+	Users []string `json:"users,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.users.list
+func (a *API) UsergroupsUsersListActivity(ctx context.Context, req *UsergroupsUsersListRequest) (*UsergroupsUsersListResponse, error) {
+	query := url.Values{}
+	query.Set("usergroup", req.Usergroup)
+	if req.IncludeDisabled {
+		query.Set("include_disabled", "true")
+	}
+	if req.TeamID != "" {
+		query.Set("team_id", req.TeamID)
+	}
+
+	resp := new(UsergroupsUsersListResponse)
+	if err := a.httpGet(ctx, UsergroupsUsersListName, query, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// UsergroupsUsersUpdateRequest's Users is a comma-separated list of user
+// IDs, which replaces the entire list of users in the user group.
+//
+// https://docs.slack.dev/reference/methods/usergroups.users.update
+type UsergroupsUsersUpdateRequest struct {
+	CallOptions
+
+	Usergroup string `json:"usergroup"`
+	Users     string `json:"users"`
+
+	AdditionalChannels string `json:"additional_channels,omitempty"`
+	IncludeCount       bool   `json:"include_count,omitempty"`
+	IsShared           bool   `json:"is_shared,omitempty"`
+	TeamID             string `json:"team_id,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.users.update
+type UsergroupsUsersUpdateResponse struct {
+	slackResponse
+
+	Usergroup *UserGroup `json:"usergroup,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/usergroups.users.update
+func (a *API) UsergroupsUsersUpdateActivity(ctx context.Context, req *UsergroupsUsersUpdateRequest) (*UsergroupsUsersUpdateResponse, error) {
+	resp := new(UsergroupsUsersUpdateResponse)
+	if err := a.httpPost(ctx, UsergroupsUsersUpdateName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
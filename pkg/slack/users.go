@@ -2,23 +2,28 @@ package slack
 
 import (
 	"context"
-	"errors"
 	"net/url"
 	"strconv"
 )
 
 const (
-	UsersConversationsName = "slack.users.conversations"
-	UsersGetPresenceName   = "slack.users.getPresence"
-	UsersIdentityName      = "slack.users.identity"
-	UsersInfoName          = "slack.users.info"
-	UsersListName          = "slack.users.list"
-	UsersLookupByEmailName = "slack.users.lookupByEmail"
-	UsersProfileGetName    = "slack.users.profile.get"
+	UsersConversationsName    = "slack.users.conversations"
+	UsersConversationsAllName = "slack.users.conversations.all"
+	UsersGetPresenceName      = "slack.users.getPresence"
+	UsersIdentityName         = "slack.users.identity"
+	UsersInfoName             = "slack.users.info"
+	UsersListName             = "slack.users.list"
+	UsersListAllName          = "slack.users.list.all"
+	UsersLookupByEmailName    = "slack.users.lookupByEmail"
+	UsersProfileGetName       = "slack.users.profile.get"
+	UsersProfileSetName       = "slack.users.profile.set"
+	UsersSetPresenceName      = "slack.users.setPresence"
 )
 
 // https://docs.slack.dev/reference/methods/users.conversations
 type UsersConversationsRequest struct {
+	CallOptions
+
 	Cursor          string `json:"cursor,omitempty"`
 	ExcludeArchived bool   `json:"exclude_archived,omitempty"`
 	Limit           int    `json:"limit,omitempty"`
@@ -31,7 +36,7 @@ type UsersConversationsRequest struct {
 type UsersConversationsResponse struct {
 	slackResponse
 
-	Channels []map[string]any `json:"channels,omitempty"`
+	Channels []Channel `json:"channels,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/users.conversations
@@ -61,13 +66,46 @@ func (a *API) UsersConversationsActivity(ctx context.Context, req *UsersConversa
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
+// https://docs.slack.dev/reference/methods/users.conversations
+type UsersConversationsAllRequest struct {
+	UsersConversationsRequest
+
+	MaxItems int `json:"max_items,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/users.conversations
+type UsersConversationsAllResponse struct {
+	Channels   []Channel `json:"channels,omitempty"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/users.conversations
+func (a *API) UsersConversationsAllActivity(ctx context.Context, req *UsersConversationsAllRequest) (*UsersConversationsAllResponse, error) {
+	items, next, err := paginate(ctx, req.Cursor, req.MaxItems, func(ctx context.Context, cursor string) ([]Channel, string, error) {
+		page := req.UsersConversationsRequest
+		page.Cursor = cursor
+		resp, err := a.UsersConversationsActivity(ctx, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Channels, resp.nextCursor(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UsersConversationsAllResponse{Channels: items, NextCursor: next}, nil
+}
+
 // https://docs.slack.dev/reference/methods/users.getPresence
 type UsersGetPresenceRequest struct {
+	CallOptions
+
 	User string `json:"user,omitempty"`
 }
 
@@ -95,20 +133,22 @@ func (a *API) UsersGetPresenceActivity(ctx context.Context, req *UsersGetPresenc
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/users.identity
-type UsersIdentityRequest struct{}
+type UsersIdentityRequest struct {
+	CallOptions
+}
 
 // https://docs.slack.dev/reference/methods/users.identity
 type UsersIdentityResponse struct {
 	slackResponse
 
-	User map[string]any `json:"user,omitempty"`
-	Team map[string]any `json:"team,omitempty"`
+	User *User `json:"user,omitempty"`
+	Team *Team `json:"team,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/users.identity
@@ -118,13 +158,15 @@ func (a *API) UsersIdentityActivity(ctx context.Context, req *UsersIdentityReque
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/users.info
 type UsersInfoRequest struct {
+	CallOptions
+
 	User string `json:"user"`
 
 	IncludeLocale bool `json:"include_locale,omitempty"`
@@ -134,7 +176,7 @@ type UsersInfoRequest struct {
 type UsersInfoResponse struct {
 	slackResponse
 
-	User map[string]any `json:"user,omitempty"`
+	User *User `json:"user,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/users.info
@@ -150,13 +192,15 @@ func (a *API) UsersInfoActivity(ctx context.Context, req *UsersInfoRequest) (*Us
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/users.list
 type UsersListRequest struct {
+	CallOptions
+
 	Cursor        string `json:"cursor,omitempty"`
 	IncludeLocale bool   `json:"include_locale,omitempty"`
 	Limit         int    `json:"limit,omitempty"`
@@ -167,8 +211,8 @@ type UsersListRequest struct {
 type UsersListResponse struct {
 	slackResponse
 
-	Members []map[string]any `json:"members,omitempty"`
-	CacheTS int64            `json:"cache_ts,omitempty"`
+	Members []User `json:"members,omitempty"`
+	CacheTS int64  `json:"cache_ts,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/users.list
@@ -192,13 +236,46 @@ func (a *API) UsersListActivity(ctx context.Context, req *UsersListRequest) (*Us
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
+// https://docs.slack.dev/reference/methods/users.list
+type UsersListAllRequest struct {
+	UsersListRequest
+
+	MaxItems int `json:"max_items,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/users.list
+type UsersListAllResponse struct {
+	Members    []User `json:"members,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/users.list
+func (a *API) UsersListAllActivity(ctx context.Context, req *UsersListAllRequest) (*UsersListAllResponse, error) {
+	items, next, err := paginate(ctx, req.Cursor, req.MaxItems, func(ctx context.Context, cursor string) ([]User, string, error) {
+		page := req.UsersListRequest
+		page.Cursor = cursor
+		resp, err := a.UsersListActivity(ctx, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Members, resp.nextCursor(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UsersListAllResponse{Members: items, NextCursor: next}, nil
+}
+
 // https://docs.slack.dev/reference/methods/users.lookupByEmail
 type UsersLookupByEmailRequest struct {
+	CallOptions
+
 	Email string `json:"email"`
 }
 
@@ -206,7 +283,7 @@ type UsersLookupByEmailRequest struct {
 type UsersLookupByEmailResponse struct {
 	slackResponse
 
-	User map[string]any `json:"user,omitempty"`
+	User *User `json:"user,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/users.lookupByEmail
@@ -219,13 +296,15 @@ func (a *API) UsersLookupByEmailActivity(ctx context.Context, req *UsersLookupBy
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
 
 // https://docs.slack.dev/reference/methods/users.profile.get
 type UsersProfileGetRequest struct {
+	CallOptions
+
 	IncludeLabels bool   `json:"include_labels,omitempty"`
 	User          string `json:"user,omitempty"`
 }
@@ -234,7 +313,7 @@ type UsersProfileGetRequest struct {
 type UsersProfileGetResponse struct {
 	slackResponse
 
-	Profile map[string]any `json:"profile,omitempty"`
+	Profile *Profile `json:"profile,omitempty"`
 }
 
 // https://docs.slack.dev/reference/methods/users.profile.get
@@ -252,7 +331,66 @@ func (a *API) UsersProfileGetActivity(ctx context.Context, req *UsersProfileGetR
 		return nil, err
 	}
 	if !resp.OK {
-		return nil, errors.New("Slack API error: " + resp.Error)
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// Profile fields are set only if they're not empty. To clear a field (e.g. to
+// clear a custom status), set it to an empty string in the profile's Extra,
+// or use Name and Value instead of Profile. User requires an admin token.
+//
+// https://docs.slack.dev/reference/methods/users.profile.set
+type UsersProfileSetRequest struct {
+	CallOptions
+
+	Name    string   `json:"name,omitempty"`
+	Profile *Profile `json:"profile,omitempty"`
+	User    string   `json:"user,omitempty"`
+	Value   string   `json:"value,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/users.profile.set
+type UsersProfileSetResponse struct {
+	slackResponse
+
+	Profile *Profile `json:"profile,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/users.profile.set
+func (a *API) UsersProfileSetActivity(ctx context.Context, req *UsersProfileSetRequest) (*UsersProfileSetResponse, error) {
+	resp := new(UsersProfileSetResponse)
+	if err := a.httpPost(ctx, UsersProfileSetName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// Presence is either "auto" or "away".
+//
+// https://docs.slack.dev/reference/methods/users.setPresence
+type UsersSetPresenceRequest struct {
+	CallOptions
+
+	Presence string `json:"presence"`
+}
+
+// https://docs.slack.dev/reference/methods/users.setPresence
+type UsersSetPresenceResponse struct {
+	slackResponse
+}
+
+// https://docs.slack.dev/reference/methods/users.setPresence
+func (a *API) UsersSetPresenceActivity(ctx context.Context, req *UsersSetPresenceRequest) (*UsersSetPresenceResponse, error) {
+	resp := new(UsersSetPresenceResponse)
+	if err := a.httpPost(ctx, UsersSetPresenceName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
 	}
 	return resp, nil
 }
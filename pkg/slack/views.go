@@ -0,0 +1,133 @@
+package slack
+
+import (
+	"context"
+)
+
+const (
+	ViewsOpenName    = "slack.views.open"
+	ViewsPublishName = "slack.views.publish"
+	ViewsPushName    = "slack.views.push"
+	ViewsUpdateName  = "slack.views.update"
+)
+
+// https://docs.slack.dev/reference/methods/views.open
+type ViewsOpenRequest struct {
+	CallOptions
+
+	View *View `json:"view"`
+
+	TriggerID            string `json:"trigger_id,omitempty"`
+	InteractivityPointer string `json:"interactivity_pointer,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/views.open
+type ViewsOpenResponse struct {
+	slackResponse
+
+	View *View `json:"view,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/views.open
+func (a *API) ViewsOpenActivity(ctx context.Context, req *ViewsOpenRequest) (*ViewsOpenResponse, error) {
+	resp := new(ViewsOpenResponse)
+	if err := a.httpPost(ctx, ViewsOpenName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/views.publish
+type ViewsPublishRequest struct {
+	CallOptions
+
+	UserID string `json:"user_id"`
+	View   *View  `json:"view"`
+
+	Hash string `json:"hash,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/views.publish
+type ViewsPublishResponse struct {
+	slackResponse
+
+	View *View `json:"view,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/views.publish
+func (a *API) ViewsPublishActivity(ctx context.Context, req *ViewsPublishRequest) (*ViewsPublishResponse, error) {
+	resp := new(ViewsPublishResponse)
+	if err := a.httpPost(ctx, ViewsPublishName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// https://docs.slack.dev/reference/methods/views.push
+type ViewsPushRequest struct {
+	CallOptions
+
+	View *View `json:"view"`
+
+	TriggerID            string `json:"trigger_id,omitempty"`
+	InteractivityPointer string `json:"interactivity_pointer,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/views.push
+type ViewsPushResponse struct {
+	slackResponse
+
+	View *View `json:"view,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/views.push
+func (a *API) ViewsPushActivity(ctx context.Context, req *ViewsPushRequest) (*ViewsPushResponse, error) {
+	resp := new(ViewsPushResponse)
+	if err := a.httpPost(ctx, ViewsPushName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
+
+// ViewsUpdateRequest identifies the view to update by either its
+// ViewID or its ExternalID. If Hash is specified, the update fails
+// with a "hash_conflict" error if the view was modified since then.
+//
+// https://docs.slack.dev/reference/methods/views.update
+type ViewsUpdateRequest struct {
+	CallOptions
+
+	View *View `json:"view"`
+
+	ViewID     string `json:"view_id,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+	Hash       string `json:"hash,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/views.update
+type ViewsUpdateResponse struct {
+	slackResponse
+
+	View *View `json:"view,omitempty"`
+}
+
+// https://docs.slack.dev/reference/methods/views.update
+func (a *API) ViewsUpdateActivity(ctx context.Context, req *ViewsUpdateRequest) (*ViewsUpdateResponse, error) {
+	resp := new(ViewsUpdateResponse)
+	if err := a.httpPost(ctx, ViewsUpdateName, req, resp); err != nil {
+		return nil, err
+	}
+	if !resp.OK {
+		return nil, resp.apiError()
+	}
+	return resp, nil
+}
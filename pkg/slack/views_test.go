@@ -0,0 +1,42 @@
+package slack
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tzrikka/ovid/pkg/slack/blocks"
+)
+
+func TestViewsOpenRequest(t *testing.T) {
+	bs, err := blocks.View(&blocks.Section{Text: blocks.Markdown("Hello")})
+	if err != nil {
+		t.Fatalf("blocks.View() error = %v", err)
+	}
+
+	req := &ViewsOpenRequest{
+		TriggerID: "T1",
+		View: &View{
+			Type:       "modal",
+			Title:      blocks.PlainText("Title"),
+			Blocks:     bs,
+			CallbackID: WorkflowTarget{WorkflowID: "wid", Signal: "submit"}.String(),
+			Extra:      map[string]any{"private_field": true},
+		},
+	}
+
+	got, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	want := `{"trigger_id": "T1", "view": {
+		"type": "modal",
+		"title": {"type": "plain_text", "text": "Title", "emoji": true},
+		"blocks": [{"type": "section", "text": {"type": "mrkdwn", "text": "Hello"}}],
+		"callback_id": "ovid:signal:submit::wid",
+		"private_field": true
+	}}`
+	if !jsonEqual(t, got, []byte(want)) {
+		t.Errorf("json.Marshal() = %s", got)
+	}
+}